@@ -0,0 +1,212 @@
+package dsfhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// assetDefinitionsSources are the values of asset_definitions_source
+var assetDefinitionsSources = []string{"embedded", "hub", "file"}
+
+// assetDefinitions are the asset schema, the credential fields of each
+// auth_mechanism and the required fields of each server type, used to validate
+// assets and build their payload
+type assetDefinitions struct {
+	assetSchemaJson                string
+	authMechanismAllowedFieldsJson string
+	// requiredFieldsJson is the required fields json of each resource type
+	requiredFieldsJson map[string]string
+	enumValues         map[string][]string
+}
+
+// assetDefinitionsOverride is the document read from the hub or from
+// asset_definitions_file. Its fields are merged over the embedded definitions,
+// fields and server types the provider does not know are added.
+type assetDefinitionsOverride struct {
+	Details        map[string]interface{} `json:"details"`
+	Connections    map[string]interface{} `json:"connections"`
+	AuthMechanisms map[string]interface{} `json:"auth_mechanisms"`
+	// ServerTypes are the required fields of the server types of each
+	// resource type
+	ServerTypes map[string]map[string]interface{} `json:"server_types"`
+}
+
+// embeddedAssetDefinitions are the definitions compiled into the provider
+var embeddedAssetDefinitions = &assetDefinitions{
+	assetSchemaJson:                assetSchemaJson,
+	authMechanismAllowedFieldsJson: authMechanismAllowedFieldsJson,
+	requiredFieldsJson: map[string]string{
+		dsfCloudAccountResourceType:  requiredCloudAccountJson,
+		dsfDataSourceResourceType:    requiredDataSourceFieldsJson,
+		dsfLogAggregatorResourceType: requiredLogAggregatorJson,
+		dsfSecretManagerResourceType: requiredSecretManagerFieldsJson,
+	},
+	enumValues: assetSchemaEnumValues,
+}
+
+// assetDefinitions returns the definitions loaded when the client was
+// configured, from the hub or from a file, or the embedded definitions
+func (c *Client) assetDefinitions() *assetDefinitions {
+	if c == nil || c.definitions == nil {
+		return embeddedAssetDefinitions
+	}
+	return c.definitions
+}
+
+// modelledAssetFields are the ids of the fields that the provider has an
+// attribute for. Fields only known from loaded definitions are passed through.
+var modelledAssetFields = func() map[string]bool {
+	fields := map[string]bool{}
+	assetSchema := getSchemaFromJson(assetSchemaJson)
+	for _, schemaFields := range []map[string]SchemaField{assetSchema.Details, assetSchema.Connections} {
+		for _, field := range schemaFields {
+			fields[field.ID] = true
+		}
+	}
+	return fields
+}()
+
+// loadAssetDefinitions loads the definitions of asset_definitions_source into
+// the client
+func (c *Client) loadAssetDefinitions() error {
+	var override []byte
+	var err error
+	switch c.config.AssetDefinitionsSource {
+	case "hub":
+		override, err = c.ReadAssetDefinitions()
+	case "file":
+		log.Printf("[INFO] Reading asset definitions from %s\n", c.config.AssetDefinitionsFile)
+		override, err = os.ReadFile(c.config.AssetDefinitionsFile)
+	default:
+		c.definitions = nil
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading asset definitions from %s: %s", c.config.AssetDefinitionsSource, err)
+	}
+
+	definitions, err := mergeAssetDefinitions(embeddedAssetDefinitions, override)
+	if err != nil {
+		return fmt.Errorf("error loading asset definitions from %s: %s", c.config.AssetDefinitionsSource, err)
+	}
+	c.definitions = definitions
+	return nil
+}
+
+// mergeAssetDefinitions returns base with the definitions of the override json
+// document merged over it
+func mergeAssetDefinitions(base *assetDefinitions, overrideJson []byte) (*assetDefinitions, error) {
+	var override assetDefinitionsOverride
+	if err := json.Unmarshal(overrideJson, &override); err != nil {
+		return nil, err
+	}
+
+	assetSchema, err := mergeJsonObject(base.assetSchemaJson, map[string]interface{}{
+		"details":     override.Details,
+		"connections": override.Connections,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var parsedAssetSchema AssetSchema
+	if err := json.Unmarshal([]byte(assetSchema), &parsedAssetSchema); err != nil {
+		return nil, fmt.Errorf("invalid details or connections: %s", err)
+	}
+	for _, fields := range []map[string]SchemaField{parsedAssetSchema.Details, parsedAssetSchema.Connections} {
+		for name, field := range fields {
+			if field.ID == "" {
+				return nil, fmt.Errorf("field %s has no id", name)
+			}
+		}
+	}
+
+	authMechanisms, err := mergeJsonObject(base.authMechanismAllowedFieldsJson, map[string]interface{}{
+		"auth_mechanisms": override.AuthMechanisms,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var parsedAuthMechanisms RequiredFields
+	if err := json.Unmarshal([]byte(authMechanisms), &parsedAuthMechanisms); err != nil {
+		return nil, fmt.Errorf("invalid auth_mechanisms: %s", err)
+	}
+
+	definitions := &assetDefinitions{
+		assetSchemaJson:                assetSchema,
+		authMechanismAllowedFieldsJson: authMechanisms,
+		requiredFieldsJson:             map[string]string{},
+		enumValues:                     parseAssetSchemaEnumValues(assetSchema),
+	}
+	for resourceType := range override.ServerTypes {
+		if _, found := base.requiredFieldsJson[resourceType]; !found {
+			return nil, fmt.Errorf("server_types of unknown resource type %s", resourceType)
+		}
+	}
+	for resourceType, requiredFieldsJson := range base.requiredFieldsJson {
+		merged, err := mergeJsonObject(requiredFieldsJson, map[string]interface{}{
+			"ServerTypes": override.ServerTypes[resourceType],
+		})
+		if err != nil {
+			return nil, err
+		}
+		var requiredFields RequiredFieldsMap
+		if err := json.Unmarshal([]byte(merged), &requiredFields); err != nil {
+			return nil, fmt.Errorf("invalid server_types of %s: %s", resourceType, err)
+		}
+		definitions.requiredFieldsJson[resourceType] = merged
+	}
+	return definitions, nil
+}
+
+// mergeJsonObject returns the json object baseJson with override merged over
+// it by mergeJsonValues
+func mergeJsonObject(baseJson string, override map[string]interface{}) (string, error) {
+	var base interface{}
+	if err := json.Unmarshal([]byte(baseJson), &base); err != nil {
+		return "", err
+	}
+	merged, err := json.Marshal(mergeJsonValues(base, override))
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+// mergeJsonValues returns override merged over base: objects are merged key by
+// key, other values of override replace those of base, and nil values of
+// override leave base unchanged
+func mergeJsonValues(base interface{}, override interface{}) interface{} {
+	overrideObject, ok := override.(map[string]interface{})
+	if !ok {
+		if override == nil {
+			return base
+		}
+		return override
+	}
+	if overrideObject == nil {
+		return base
+	}
+	baseObject, ok := base.(map[string]interface{})
+	if !ok {
+		return override
+	}
+
+	merged := make(map[string]interface{}, len(baseObject))
+	for key, value := range baseObject {
+		merged[key] = value
+	}
+	for key, value := range overrideObject {
+		merged[key] = mergeJsonValues(baseObject[key], value)
+	}
+	return merged
+}
+
+// validateAssetDefinitionsSource validates asset_definitions_source
+func validateAssetDefinitionsSource(i interface{}, path cty.Path) diag.Diagnostics {
+	return enumValueDiagnostics("asset_definitions_source", i.(string), assetDefinitionsSources, path)
+}
@@ -0,0 +1,257 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testAssetDefinitionsOverride adds a server type requiring a field that has
+// no attribute, and a value of used_for
+const testAssetDefinitionsOverride = `{
+	"details": {
+		"NewField": {"id": "new_field", "description": "A field added by the hub", "type": "string"},
+		"UsedFor": {"values": ["Production", "Test", "Sandbox"]}
+	},
+	"server_types": {
+		"dsfhub_data_source": {
+			"NEW DB": {
+				"required": ["admin_email", "asset_display_name", "asset_id", "gateway_id", "new_field", "server_host_name", "server_type"],
+				"auth_mechanisms": {"password": ["password", "username"]}
+			}
+		}
+	}
+}`
+
+// testAssetDefinitionsClient returns a client with override merged over the
+// embedded asset definitions
+func testAssetDefinitionsClient(t *testing.T, override string) *Client {
+	definitions, err := mergeAssetDefinitions(embeddedAssetDefinitions, []byte(override))
+	if err != nil {
+		t.Fatalf("Should have merged the asset definitions: %s", err)
+	}
+	return &Client{config: &Config{}, definitions: definitions}
+}
+
+func TestMergeAssetDefinitions(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestMergeAssetDefinitions \n")
+
+	definitions, err := mergeAssetDefinitions(embeddedAssetDefinitions, []byte(testAssetDefinitionsOverride))
+	if err != nil {
+		t.Fatalf("Should have merged the asset definitions: %s", err)
+	}
+
+	serverTypes := requiredFieldsServerTypes(definitions.requiredFieldsJson[dsfDataSourceResourceType])
+	for _, serverType := range []string{"NEW DB", "MYSQL"} {
+		if !contains(serverTypes, serverType) {
+			t.Errorf("Should have listed server type %s. Got: %v", serverType, serverTypes)
+		}
+	}
+	if contains(requiredFieldsServerTypes(definitions.requiredFieldsJson[dsfLogAggregatorResourceType]), "NEW DB") {
+		t.Errorf("Should not have added NEW DB to %s", dsfLogAggregatorResourceType)
+	}
+
+	assetSchema := getSchemaFromJson(definitions.assetSchemaJson)
+	if assetSchema.Details["NewField"].ID != "new_field" {
+		t.Errorf("Should have added NewField. Got: %v", assetSchema.Details["NewField"])
+	}
+	if usedFor := assetSchema.Details["UsedFor"]; usedFor.ID != "used_for" || usedFor.DisplayName == "" {
+		t.Errorf("Should have kept the definition of UsedFor other than its values. Got: %v", usedFor)
+	}
+	if !contains(definitions.enumValues["used_for"], "Sandbox") || contains(definitions.enumValues["used_for"], "Development") {
+		t.Errorf("Should have replaced the values of used_for. Got: %v", definitions.enumValues["used_for"])
+	}
+
+	if contains(requiredFieldsServerTypes(requiredDataSourceFieldsJson), "NEW DB") {
+		t.Errorf("Should not have changed the embedded definitions")
+	}
+}
+
+func TestMergeAssetDefinitionsInvalid(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestMergeAssetDefinitionsInvalid \n")
+
+	testCases := []struct {
+		override string
+		expected string
+	}{
+		{`{`, "unexpected end of JSON input"},
+		{`{"server_types": {"dsfhub_unknown": {}}}`, "server_types of unknown resource type dsfhub_unknown"},
+		{`{"details": {"NewField": {"description": "no id"}}}`, "field NewField has no id"},
+		{`{"server_types": {"dsfhub_data_source": {"NEW DB": {"required": "server_type"}}}}`, "invalid server_types of dsfhub_data_source"},
+	}
+	for _, tc := range testCases {
+		_, err := mergeAssetDefinitions(embeddedAssetDefinitions, []byte(tc.override))
+		if err == nil {
+			t.Errorf("%s should have been invalid", tc.override)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), tc.expected) {
+			t.Errorf("%s should have returned %q. Got: %q", tc.override, tc.expected, err)
+		}
+	}
+}
+
+func TestLoadAssetDefinitionsFromHub(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestLoadAssetDefinitionsFromHub \n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.String() {
+		case baseAPIPrefix + endpointGateways:
+			rw.Write([]byte(`{"data": []}`))
+		case baseAPIPrefix + endpointAssetDefinitions:
+			rw.Write([]byte(`{"data": ` + testAssetDefinitionsOverride + `}`))
+		default:
+			t.Errorf("Should not have hit %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := Config{DSFHUBToken: "good", DSFHUBHost: server.URL, AssetDefinitionsSource: "hub"}
+	meta, err := config.Client()
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	client := meta.(*Client)
+	if !contains(requiredFieldsServerTypes(client.assetDefinitions().requiredFieldsJson[dsfDataSourceResourceType]), "NEW DB") {
+		t.Errorf("NEW DB should have been a server type of the client")
+	}
+
+	// the definitions of a client configured with another source are its own
+	embeddedConfig := Config{DSFHUBToken: "good", DSFHUBHost: server.URL, AssetDefinitionsSource: "embedded"}
+	embeddedMeta, err := embeddedConfig.Client()
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if embeddedMeta.(*Client).assetDefinitions() != embeddedAssetDefinitions {
+		t.Errorf("Should have used the embedded definitions")
+	}
+	if !contains(requiredFieldsServerTypes(client.assetDefinitions().requiredFieldsJson[dsfDataSourceResourceType]), "NEW DB") {
+		t.Errorf("Configuring another client should not have changed the definitions of the first one")
+	}
+}
+
+func TestLoadAssetDefinitionsFromFile(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestLoadAssetDefinitionsFromFile \n")
+
+	path := filepath.Join(t.TempDir(), "asset_definitions.json")
+	if err := os.WriteFile(path, []byte(testAssetDefinitionsOverride), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &Client{config: &Config{AssetDefinitionsSource: "file", AssetDefinitionsFile: path}}
+	if err := client.loadAssetDefinitions(); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if !contains(client.assetDefinitions().enumValues["used_for"], "Sandbox") {
+		t.Errorf("Sandbox should have been a value of used_for. Got: %v", client.assetDefinitions().enumValues["used_for"])
+	}
+
+	client.config.AssetDefinitionsFile = filepath.Join(t.TempDir(), "missing.json")
+	err := client.loadAssetDefinitions()
+	if err == nil || !strings.HasPrefix(err.Error(), "error reading asset definitions from file") {
+		t.Errorf("Should have received an error reading the file. Got: %v", err)
+	}
+}
+
+func TestInvalidAssetDefinitionsSource(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestInvalidAssetDefinitionsSource \n")
+
+	for _, tc := range []struct {
+		config   Config
+		expected string
+	}{
+		{Config{DSFHUBToken: "good", DSFHUBHost: "https://1.2.3.4:8443", AssetDefinitionsSource: "remote"}, invalidAssetDefinitionsSourceMessage},
+		{Config{DSFHUBToken: "good", DSFHUBHost: "https://1.2.3.4:8443", AssetDefinitionsSource: "file"}, missingAssetDefinitionsFileMessage},
+	} {
+		client, err := tc.config.Client()
+		if err == nil {
+			t.Errorf("Should have received an error, got a client: %q", client)
+			continue
+		}
+		if err.Error() != tc.expected {
+			t.Errorf("Should have received %q, got: %s", tc.expected, err)
+		}
+	}
+}
+
+func TestAssetSchemaEnumCustomizeDiffLoadedDefinitions(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetSchemaEnumCustomizeDiffLoadedDefinitions \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-new-db",
+		"asset_id":           "my-new-db",
+		"gateway_id":         "my-gateway",
+		"new_field":          "value",
+		"server_host_name":   "my-new-db.example.com",
+		"server_type":        "NEW DB",
+		"used_for":           "Sandbox",
+	})
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	for _, expected := range []string{`invalid value "NEW DB" for server_type`, `invalid value "Sandbox" for used_for`} {
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Should have returned %q with the embedded definitions. Got: %v", expected, err)
+		}
+	}
+
+	client := testAssetDefinitionsClient(t, testAssetDefinitionsOverride)
+	_, err = resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, client)
+	if err != nil && strings.Contains(err.Error(), "invalid value") {
+		t.Errorf("NEW DB and Sandbox should have been valid with the loaded definitions. Got: %s", err)
+	}
+}
+
+func TestRequiredFieldsWithoutAttributePassThrough(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRequiredFieldsWithoutAttributePassThrough \n")
+
+	client := testAssetDefinitionsClient(t, testAssetDefinitionsOverride)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-new-db",
+		"asset_id":           "my-new-db",
+		"gateway_id":         "my-gateway",
+		"server_type":        "NEW DB",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism": "password",
+				"password":       "password",
+				"reason":         "default",
+				"username":       "username",
+			},
+		},
+	})
+
+	state := &terraform.InstanceState{RawConfig: testRawConfig(resourceDSFDataSource(), map[string]string{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-new-db",
+		"asset_id":           "my-new-db",
+		"gateway_id":         "my-gateway",
+		"server_type":        "NEW DB",
+	})}
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), state, config, client)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.Contains(err.Error(), "missing fields: \"server_host_name\"") {
+		t.Errorf("Should have received an error for missing field server_host_name, got: %s", err)
+	}
+	if strings.Contains(err.Error(), "new_field") {
+		t.Errorf("Should not have checked new_field, which has no attribute, got: %s", err)
+	}
+}
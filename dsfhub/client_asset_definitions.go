@@ -0,0 +1,47 @@
+package dsfhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+const endpointAssetDefinitions = "/assets/definitions"
+
+// AssetDefinitionsResponse is the response of the hub with the asset schema
+// and the required fields of each server type
+type AssetDefinitionsResponse struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []APIError      `json:"errors,omitempty"`
+}
+
+// ReadAssetDefinitions gets the asset schema and the required fields of each
+// server type from the hub
+func (c *Client) ReadAssetDefinitions() ([]byte, error) {
+	log.Println("[INFO] Reading asset definitions from DSF Host")
+
+	resp, err := c.MakeCall(http.MethodGet, endpointAssetDefinitions, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading asset definitions | err: %s\n", err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Asset definitions JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var assetDefinitionsResponse AssetDefinitionsResponse
+	err = json.Unmarshal([]byte(responseBody), &assetDefinitionsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing asset definitions JSON response | err: %s\n", err)
+	}
+	if assetDefinitionsResponse.Errors != nil {
+		return nil, newAPIResponseError(assetDefinitionsResponse.Errors, responseBody)
+	}
+	return assetDefinitionsResponse.Data, nil
+}
@@ -0,0 +1,47 @@
+package dsfhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+// AssetOperationResponse is the response of a hub operation run against an
+// asset. The shape of data depends on the operation.
+type AssetOperationResponse struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []APIError      `json:"errors,omitempty"`
+}
+
+// RunAssetOperation runs a hub operation against an asset, e.g.
+// POST /data-sources/{assetId}/operations/{operation}
+func (c *Client) RunAssetOperation(collectionEndpoint string, assetId string, operation string, body []byte) (*AssetOperationResponse, error) {
+	log.Printf("[INFO] Running operation %s for assetId: %v\n", operation, assetId)
+
+	reqURL := fmt.Sprintf(collectionEndpoint+"/%s/operations/%s", url.PathEscape(assetId), url.PathEscape(operation))
+	resp, err := c.MakeCall(http.MethodPost, reqURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("error running operation %s for assetId: %s | err: %s\n", operation, assetId, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Operation %s for asset '%v' JSON response: %s\n", operation, assetId, string(responseBody))
+
+	// Parse the JSON
+	var assetOperationResponse AssetOperationResponse
+	err = json.Unmarshal([]byte(responseBody), &assetOperationResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing operation %s JSON response assetId: %s | err: %s\n", operation, assetId, err)
+	}
+	if assetOperationResponse.Errors != nil {
+		return nil, newAPIResponseError(assetOperationResponse.Errors, responseBody)
+	}
+	return &assetOperationResponse, nil
+}
@@ -0,0 +1,103 @@
+package dsfhub
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+//////////////////////////////////////////////////////////////////
+//// RunAssetOperation Tests
+//////////////////////////////////////////////////////////////////
+
+func TestClientRunAssetOperationBadConnection(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientRunAssetOperationBadConnection \n")
+	config := &Config{DSFHUBToken: "foo", DSFHUBHost: testInvalidDSFHUBHost}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	assetOperationResponse, err := client.RunAssetOperation(endpointDsfDataSource, testArn, "sync", nil)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("error running operation sync for assetId: %s", testArn)) {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if assetOperationResponse != nil {
+		t.Errorf("Should have received a nil assetOperationResponse instance")
+	}
+}
+
+func TestClientRunAssetOperationInvalidAssetId(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientRunAssetOperationInvalidAssetId \n")
+	DSFHUBToken := "foo"
+	invalidAssetId := "abcde12345"
+	endpoint := fmt.Sprintf(baseAPIPrefix + endpointSecretManagers + "/" + invalidAssetId + "/operations/sync")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(404)
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{"errors":[{"status":404,"id":"1edd8d35f53490df","source":{"pointer":"/api/v2/secret-managers/abcde12345/operations/sync"},"title":"Not Found","detail":"Asset abcde12345 not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{DSFHUBToken: DSFHUBToken, DSFHUBHost: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	assetOperationResponse, err := client.RunAssetOperation(endpointSecretManagers, invalidAssetId, "sync", nil)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("errors found in json response")) {
+		t.Errorf("Should have received invalid asset id error, got: %s", err)
+	}
+	if assetOperationResponse != nil {
+		t.Errorf("Should have received a nil assetOperationResponse instance")
+	}
+}
+
+func TestClientRunAssetOperationValidAssetId(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientRunAssetOperationValidAssetId \n")
+	DSFHUBToken := "foo"
+	body := `{"force":true}`
+	endpoint := fmt.Sprint(baseAPIPrefix + endpointLogAggregators + "/" + url.PathEscape(testArn) + "/operations/sync")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Errorf("Should have used %s method. Got: %s", http.MethodPost, req.Method)
+		}
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		requestBody, _ := ioutil.ReadAll(req.Body)
+		if string(requestBody) != body {
+			t.Errorf("Should have sent body %s. Got: %s", body, string(requestBody))
+		}
+		rw.Write([]byte(`{"data":{"status":"started"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{DSFHUBToken: DSFHUBToken, DSFHUBHost: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	assetOperationResponse, err := client.RunAssetOperation(endpointLogAggregators, testArn, "sync", []byte(body))
+	if err != nil {
+		t.Errorf("Should not have received an error: %s", err)
+	}
+	if assetOperationResponse == nil {
+		t.Fatalf("Should not have received a nil assetOperationResponse instance")
+	}
+	if string(assetOperationResponse.Data) != `{"status":"started"}` {
+		t.Errorf("Should have received data {\"status\":\"started\"}. Got: %s", string(assetOperationResponse.Data))
+	}
+}
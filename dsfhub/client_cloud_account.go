@@ -41,7 +41,7 @@ func (c *Client) CreateCloudAccount(cloudAccount ResourceWrapper) (*ResourceWrap
 		return nil, fmt.Errorf("error parsing add CloudAccount JSON response serverType: %s and gatewayID: %s | err: %s\n", cloudAccount.Data.ServerType, cloudAccount.Data.GatewayID, err)
 	}
 	if createCloudAccountResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(createCloudAccountResponse.Errors, responseBody)
 	}
 	return &createCloudAccountResponse, nil
 }
@@ -70,7 +70,7 @@ func (c *Client) ReadCloudAccount(cloudAccountId string) (*ResourceWrapper, erro
 		return nil, fmt.Errorf("error parsing CloudAccount JSON response for cloudAccountId: %s | responseBody: %s err: %s\n", cloudAccountId, responseBody, err)
 	}
 	if readCloudAccountResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readCloudAccountResponse.Errors, responseBody)
 	}
 
 	return &readCloudAccountResponse, nil
@@ -97,7 +97,7 @@ func (c *Client) ReadCloudAccounts() (*ResourcesWrapper, error) {
 		return nil, fmt.Errorf("error parsing ReadCloudAccounts JSON response: %s err: %s\n", responseBody, err)
 	}
 	if readCloudAccountsResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readCloudAccountsResponse.Errors, responseBody)
 	}
 
 	return &readCloudAccountsResponse, nil
@@ -133,7 +133,7 @@ func (c *Client) UpdateCloudAccount(cloudAccountId string, cloudAccountIdData Re
 		return nil, fmt.Errorf("error parsing update CloudAccount JSON response for cloudAccountId: %s | err: %s\n", cloudAccountId, err)
 	}
 	if updateCloudAccountResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(updateCloudAccountResponse.Errors, responseBody)
 	}
 
 	return &updateCloudAccountResponse, nil
@@ -163,7 +163,7 @@ func (c *Client) DeleteCloudAccount(cloudAccountId string) (*ResourceResponse, e
 		return nil, fmt.Errorf("error parsing delete CloudAccount JSON response for cloudAccountId: %s, %s\n", cloudAccountId, err)
 	}
 	if deleteCloudAccountResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(deleteCloudAccountResponse.Errors, responseBody)
 	}
 
 	return &deleteCloudAccountResponse, nil
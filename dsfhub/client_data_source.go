@@ -42,7 +42,7 @@ func (c *Client) CreateDSFDataSource(dsfDataSource ResourceWrapper) (*ResourceWr
 		return nil, fmt.Errorf("error parsing add DSFDataSource JSON response serverType: %s and gatewayId: %s | err: %s", dsfDataSource.Data.ServerType, dsfDataSource.Data.GatewayID, err)
 	}
 	if createDSFDataSourceResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(createDSFDataSourceResponse.Errors, responseBody)
 	}
 	return &createDSFDataSourceResponse, nil
 }
@@ -72,7 +72,7 @@ func (c *Client) ReadDSFDataSource(dataSourceId string) (*ResourceWrapper, error
 	}
 
 	if readDSFDataSourceDataResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readDSFDataSourceDataResponse.Errors, responseBody)
 	}
 
 	return &readDSFDataSourceDataResponse, nil
@@ -102,7 +102,7 @@ func (c *Client) ReadDSFDataSources() (*ResourcesWrapper, error) {
 	}
 
 	if readDSFDataSourcesDataResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readDSFDataSourcesDataResponse.Errors, responseBody)
 	}
 
 	return &readDSFDataSourcesDataResponse, nil
@@ -140,7 +140,7 @@ func (c *Client) UpdateDSFDataSource(dataSourceId string, dsfDataSourceData Reso
 	}
 
 	if updateDSFDataSourceDataResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(updateDSFDataSourceDataResponse.Errors, responseBody)
 	}
 
 	return &updateDSFDataSourceDataResponse, nil
@@ -171,7 +171,7 @@ func (c *Client) DeleteDSFDataSource(dataSourceId string) (*ResourceResponse, er
 	}
 
 	if deleteDSFDataSourceResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(deleteDSFDataSourceResponse.Errors, responseBody)
 	}
 
 	return &deleteDSFDataSourceResponse, nil
@@ -201,7 +201,7 @@ func (c *Client) EnableAuditDSFDataSource(dataSourceId string) (*UpdateAuditResp
 		return nil, fmt.Errorf("error parsing enable audit DSFDataSource JSON response dataSourceId: %s | err: %s\n", dataSourceId, err)
 	}
 	if enableAuditResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(enableAuditResponse.Errors, responseBody)
 	}
 	return &enableAuditResponse, nil
 }
@@ -230,7 +230,7 @@ func (c *Client) DisableAuditDSFDataSource(dataSourceId string) (*UpdateAuditRes
 		return nil, fmt.Errorf("error parsing disable audit DSFDataSource JSON response dataSourceId: %s | err: %s\n", dataSourceId, err)
 	}
 	if disableAuditResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(disableAuditResponse.Errors, responseBody)
 	}
 	return &disableAuditResponse, nil
 }
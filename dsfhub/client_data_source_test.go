@@ -309,11 +309,40 @@ func TestClientReadDSFDataSourceInvalidDataSourceId(t *testing.T) {
 	if !strings.HasPrefix(err.Error(), fmt.Sprintf("errors found in json response")) {
 		t.Errorf("Should have received invalid dsf data source id error, got: %s", err)
 	}
+	if !isNotFoundError(err) {
+		t.Errorf("Should have received a NotFoundError, got: %T", err)
+	}
 	if readDSFDataSourceResponse != nil {
 		t.Errorf("Should have received a nil readIncapRuleResponse instance")
 	}
 }
 
+func TestClientDeleteDSFDataSourceNotFound(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientDeleteDSFDataSourceNotFound \n")
+
+	testCases := []struct {
+		status   int
+		body     string
+		notFound bool
+	}{
+		{404, "{\n  \"errors\": [\n    {\"status\": 404, \"title\": \"Not Found\"}\n  ]\n}", true},
+		{500, `{"errors":[{"status":500,"title":"Internal Server Error","detail":"status 404 from the database"}]}`, false},
+	}
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(tc.status)
+			rw.Write([]byte(tc.body))
+		}))
+		client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+		_, err := client.DeleteDSFDataSource("abcde12345")
+		if err == nil || isNotFoundError(err) != tc.notFound {
+			t.Errorf("Status %d should have returned a not found error: %v. Got: %v", tc.status, tc.notFound, err)
+		}
+		server.Close()
+	}
+}
+
 func TestClientReadDSFDataSourceValidDSFDataSourceId(t *testing.T) {
 	log.Printf("======================== BEGIN TEST ========================")
 	log.Printf("[INFO] Running test TestClientReadDSFDataSourceValidDSFDataSourceId \n")
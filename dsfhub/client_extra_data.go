@@ -0,0 +1,117 @@
+package dsfhub
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// MarshalJSON marshals the fields of AssetData with the keys of Extra merged
+// into them
+func (a AssetData) MarshalJSON() ([]byte, error) {
+	type assetData AssetData
+	return marshalWithExtraData(assetData(a), a.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields of AssetData and keeps the keys that no
+// field models in Extra
+func (a *AssetData) UnmarshalJSON(data []byte) error {
+	type assetData AssetData
+	if err := json.Unmarshal(data, (*assetData)(a)); err != nil {
+		return err
+	}
+	extra, err := unmodelledJsonKeys(data, reflect.TypeOf(*a))
+	a.Extra = extra
+	return err
+}
+
+// MarshalJSON marshals the fields of ConnectionData with the keys of Extra
+// merged into them
+func (c ConnectionData) MarshalJSON() ([]byte, error) {
+	type connectionData ConnectionData
+	return marshalWithExtraData(connectionData(c), c.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields of ConnectionData and keeps the keys
+// that no field models in Extra
+func (c *ConnectionData) UnmarshalJSON(data []byte) error {
+	type connectionData ConnectionData
+	if err := json.Unmarshal(data, (*connectionData)(c)); err != nil {
+		return err
+	}
+	extra, err := unmodelledJsonKeys(data, reflect.TypeOf(*c))
+	c.Extra = extra
+	return err
+}
+
+// marshalWithExtraData marshals v, deep merging extra into it. Nested objects
+// are merged, and the values of the fields of v take precedence over those of
+// extra.
+func marshalWithExtraData(v interface{}, extra map[string]interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	modelled, err := decodeJsonObject(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeJsonValues(extra, modelled))
+}
+
+// unmodelledJsonKeys returns the keys of the json object data that are not
+// the json key of a field of structType
+func unmodelledJsonKeys(data []byte, structType reflect.Type) (map[string]interface{}, error) {
+	object, err := decodeJsonObject(data)
+	if err != nil {
+		return nil, err
+	}
+	fields := jsonFields(structType)
+	var extra map[string]interface{}
+	for key, value := range object {
+		if _, found := fields[key]; found {
+			continue
+		}
+		if extra == nil {
+			extra = map[string]interface{}{}
+		}
+		extra[key] = value
+	}
+	return extra, nil
+}
+
+// jsonFields returns the type of each field of structType by json key
+func jsonFields(structType reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		key := jsonKey(field)
+		if key == "-" {
+			continue
+		}
+		fields[key] = field.Type
+	}
+	return fields
+}
+
+// jsonKey returns the json key of a struct field
+func jsonKey(field reflect.StructField) string {
+	key := strings.Split(field.Tag.Get("json"), ",")[0]
+	if key == "" {
+		return field.Name
+	}
+	return key
+}
+
+// decodeJsonObject decodes a json object, keeping numbers as json.Number so
+// that they are marshalled back unchanged
+func decodeJsonObject(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
@@ -40,7 +40,7 @@ func (c *Client) CreateLogAggregator(logAggregator ResourceWrapper) (*ResourceWr
 		return nil, fmt.Errorf("error parsing add LogAggregator JSON response serverType: %s and gatewayID: %s | err: %s\n", logAggregator.Data.ServerType, logAggregator.Data.GatewayID, err)
 	}
 	if createLogAggregatorResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(createLogAggregatorResponse.Errors, responseBody)
 	}
 	return &createLogAggregatorResponse, nil
 }
@@ -69,7 +69,7 @@ func (c *Client) ReadLogAggregator(logAggregatorId string) (*ResourceWrapper, er
 		return nil, fmt.Errorf("error parsing LogAggregator JSON response for logAggregatorId: %s | responseBody: %s err: %s\n", logAggregatorId, responseBody, err)
 	}
 	if readLogAggregatorResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readLogAggregatorResponse.Errors, responseBody)
 	}
 
 	return &readLogAggregatorResponse, nil
@@ -98,7 +98,7 @@ func (c *Client) ReadLogAggregators() (*ResourcesWrapper, error) {
 		return nil, fmt.Errorf("error parsing LogAggregators JSON response: %s err: %s\n", responseBody, err)
 	}
 	if readLogAggregatorsResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readLogAggregatorsResponse.Errors, responseBody)
 	}
 
 	return &readLogAggregatorsResponse, nil
@@ -134,7 +134,7 @@ func (c *Client) UpdateLogAggregator(logAggregatorId string, logAggregatorData R
 		return nil, fmt.Errorf("error parsing update LogAggregator JSON response for LogAggregatorId: %s | err: %s\n", logAggregatorId, err)
 	}
 	if updateLogAggregatorResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(updateLogAggregatorResponse.Errors, responseBody)
 	}
 
 	return &updateLogAggregatorResponse, nil
@@ -164,8 +164,66 @@ func (c *Client) DeleteLogAggregator(logAggregatorId string) (*ResourceResponse,
 		return nil, fmt.Errorf("error parsing delete LogAggregator JSON response for logAggregatorId: %s, %s\n", logAggregatorId, err)
 	}
 	if deleteLogAggregatorResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(deleteLogAggregatorResponse.Errors, responseBody)
 	}
 
 	return &deleteLogAggregatorResponse, nil
 }
+
+// EnableAuditLogAggregator enables audit collection for a LogAggregator
+func (c *Client) EnableAuditLogAggregator(logAggregatorId string) (*UpdateAuditResponse, error) {
+	log.Printf("[INFO] Enabling audit for logAggregatorId: %v\n", logAggregatorId)
+
+	reqURL := fmt.Sprintf(endpointLogAggregators+"/%s/operations/enable-audit-collection", url.PathEscape(logAggregatorId))
+	resp, err := c.MakeCall(http.MethodPost, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error enabling audit for logAggregatorId: %s | err: %s\n", logAggregatorId, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Enable audit for LogAggregator '%v' JSON response: %s\n", logAggregatorId, string(responseBody))
+
+	// Parse the JSON
+	var enableAuditResponse UpdateAuditResponse
+	err = json.Unmarshal([]byte(responseBody), &enableAuditResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing enable audit LogAggregator JSON response logAggregatorId: %s | err: %s\n", logAggregatorId, err)
+	}
+	if enableAuditResponse.Errors != nil {
+		return nil, newAPIResponseError(enableAuditResponse.Errors, responseBody)
+	}
+	return &enableAuditResponse, nil
+}
+
+// DisableAuditLogAggregator disables audit collection for a LogAggregator
+func (c *Client) DisableAuditLogAggregator(logAggregatorId string) (*UpdateAuditResponse, error) {
+	log.Printf("[INFO] Disabling audit for logAggregatorId: %v\n", logAggregatorId)
+
+	reqURL := fmt.Sprintf(endpointLogAggregators+"/%s/operations/disable-audit-collection", url.PathEscape(logAggregatorId))
+	resp, err := c.MakeCall(http.MethodPost, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error disabling audit for logAggregatorId: %s | err: %s\n", logAggregatorId, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Disable audit for LogAggregator '%v' JSON response: %s\n", logAggregatorId, string(responseBody))
+
+	// Parse the JSON
+	var disableAuditResponse UpdateAuditResponse
+	err = json.Unmarshal([]byte(responseBody), &disableAuditResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing disable audit LogAggregator JSON response logAggregatorId: %s | err: %s\n", logAggregatorId, err)
+	}
+	if disableAuditResponse.Errors != nil {
+		return nil, newAPIResponseError(disableAuditResponse.Errors, responseBody)
+	}
+	return &disableAuditResponse, nil
+}
@@ -584,3 +584,136 @@ func TestClientDeleteLogAggregatorValidLogAggregatorId(t *testing.T) {
 		t.Errorf("Should not have received a nil deleteLogAggregatorResponse instance")
 	}
 }
+
+//////////////////////////////////////////////////////////////////
+//// EnableAuditLogAggregator Tests
+//////////////////////////////////////////////////////////////////
+
+func TestClientEnableAuditLogAggregatorBadConnection(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientEnableAuditLogAggregatorBadConnection \n")
+	config := &Config{DSFHUBToken: "foo", DSFHUBHost: testInvalidDSFHUBHost}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	enableAuditResponse, err := client.EnableAuditLogAggregator(testArn)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("error enabling audit for logAggregatorId: %s", testArn)) {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if enableAuditResponse != nil {
+		t.Errorf("Should have received a nil enableAuditResponse instance")
+	}
+}
+
+func TestClientEnableAuditLogAggregatorInvalidLogAggregatorId(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientEnableAuditLogAggregatorInvalidLogAggregatorId \n")
+	DSFHUBToken := "foo"
+	invalidLogAggregatorId := "abcde12345"
+	endpoint := fmt.Sprintf(baseAPIPrefix + endpointLogAggregators + "/" + invalidLogAggregatorId + "/operations/enable-audit-collection")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(404)
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{"errors":[{"status":404,"id":"1edd8d35f53490df","source":{"pointer":"/api/v2/log-aggregators/abcde12345/operations/enable-audit-collection"},"title":"Not Found","detail":"Asset abcde12345 not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{DSFHUBToken: DSFHUBToken, DSFHUBHost: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	enableAuditResponse, err := client.EnableAuditLogAggregator(invalidLogAggregatorId)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("errors found in json response")) {
+		t.Errorf("Should have received invalid log aggregator id error, got: %s", err)
+	}
+	if enableAuditResponse != nil {
+		t.Errorf("Should have received a nil enableAuditResponse instance")
+	}
+}
+
+func TestClientEnableAuditLogAggregatorValidLogAggregatorId(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientEnableAuditLogAggregatorValidLogAggregatorId \n")
+	DSFHUBToken := "foo"
+	endpoint := fmt.Sprint(baseAPIPrefix + endpointLogAggregators + "/" + url.PathEscape(testArn) + "/operations/enable-audit-collection")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Errorf("Should have used %s method. Got: %s", http.MethodPost, req.Method)
+		}
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{"data":"Audit collection enabled"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{DSFHUBToken: DSFHUBToken, DSFHUBHost: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	enableAuditResponse, err := client.EnableAuditLogAggregator(testArn)
+	if err != nil {
+		t.Errorf("Should not have received an error: %s", err)
+	}
+	if enableAuditResponse == nil {
+		t.Errorf("Should not have received a nil enableAuditResponse instance")
+	}
+}
+
+//////////////////////////////////////////////////////////////////
+//// DisableAuditLogAggregator Tests
+//////////////////////////////////////////////////////////////////
+
+func TestClientDisableAuditLogAggregatorBadConnection(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientDisableAuditLogAggregatorBadConnection \n")
+	config := &Config{DSFHUBToken: "foo", DSFHUBHost: testInvalidDSFHUBHost}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	disableAuditResponse, err := client.DisableAuditLogAggregator(testArn)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("error disabling audit for logAggregatorId: %s", testArn)) {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if disableAuditResponse != nil {
+		t.Errorf("Should have received a nil disableAuditResponse instance")
+	}
+}
+
+func TestClientDisableAuditLogAggregatorValidLogAggregatorId(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestClientDisableAuditLogAggregatorValidLogAggregatorId \n")
+	DSFHUBToken := "foo"
+	endpoint := fmt.Sprint(baseAPIPrefix + endpointLogAggregators + "/" + url.PathEscape(testArn) + "/operations/disable-audit-collection")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Errorf("Should have used %s method. Got: %s", http.MethodPost, req.Method)
+		}
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{"data":"Audit collection disabled"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{DSFHUBToken: DSFHUBToken, DSFHUBHost: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	disableAuditResponse, err := client.DisableAuditLogAggregator(testArn)
+	if err != nil {
+		t.Errorf("Should not have received an error: %s", err)
+	}
+	if disableAuditResponse == nil {
+		t.Errorf("Should not have received a nil disableAuditResponse instance")
+	}
+}
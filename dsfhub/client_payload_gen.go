@@ -0,0 +1,202 @@
+// Code generated by internal/generate/assetschema from asset_schema.json; DO NOT EDIT.
+
+package dsfhub
+
+// AssetData is the assetData of an asset
+type AssetData struct {
+	ParentAssetID             string                 `json:"parent_asset_id,omitempty"`
+	AdminEmail                string                 `json:"admin_email"`
+	Application               string                 `json:"application,omitempty"`
+	Archive                   *bool                  `json:"archive,omitempty"`
+	Arn                       string                 `json:"arn,omitempty"`
+	AssetDisplayName          string                 `json:"asset_display_name"`
+	AssetID                   string                 `json:"asset_id,omitempty"`
+	AssetSource               string                 `json:"Asset Source,omitempty"`
+	AuditDataType             string                 `json:"audit_data_type,omitempty"`
+	AuditInfo                 *AuditInfo             `json:"audit_info,omitempty"`
+	AuditPullEnabled          bool                   `json:"audit_pull_enabled,omitempty"`
+	AuditType                 string                 `json:"audit_type,omitempty"`
+	AvailableBucketAccountIds []interface{}          `json:"available_bucket_account_ids,omitempty"`
+	AvailabilityZones         []interface{}          `json:"availability_zones,omitempty"`
+	AvailableRegions          []interface{}          `json:"available_regions,omitempty"`
+	AwsProxyConfig            *AwsProxyConfig        `json:"aws_proxy_config,omitempty"`
+	BucketAccountId           string                 `json:"bucket_account_id,omitempty"`
+	CaCertsPath               string                 `json:"ca_certs_path,omitempty"`
+	CaFile                    string                 `json:"ca_file,omitempty"`
+	ClusterEngine             string                 `json:"cluster_engine,omitempty"`
+	ClusterID                 string                 `json:"cluster_id,omitempty"`
+	ClusterMemberID           string                 `json:"cluster_member_id,omitempty"`
+	ClusterName               string                 `json:"cluster_name,omitempty"`
+	Connections               []AssetConnection      `json:"connections,omitempty"`
+	ConsumerGroup             string                 `json:"consumer_group,omitempty"`
+	ConsumerGroupWorkers      string                 `json:"consumer_group_workers,omitempty"`
+	ConsumerWorkerPrefix      string                 `json:"consumer_worker_prefix,omitempty"`
+	ContentType               string                 `json:"content_type,omitempty"`
+	CredentialsEndpoint       string                 `json:"credentials_endpoint,omitempty"`
+	Criticality               int                    `json:"criticality,omitempty"`
+	DatabaseName              string                 `json:"database_name,omitempty"`
+	DbEngine                  string                 `json:"db_engine,omitempty"`
+	DbInstancesDisplayName    []interface{}          `json:"db_instances_display_name,omitempty"`
+	DurationThreshold         int                    `json:"duration_threshold,omitempty"`
+	EnableAuditManagement     bool                   `json:"enable_audit_management,omitempty"`
+	EnableAuditMonitoring     bool                   `json:"enable_audit_monitoring,omitempty"`
+	EnabledLogsExports        []interface{}          `json:"enabled_logs_exports,omitempty"`
+	Endpoint                  string                 `json:"endpoint,omitempty"`
+	EntitlementEnabled        bool                   `json:"entitlement_enabled,omitempty"`
+	GatewayService            string                 `json:"gateway_service,omitempty"`
+	HostTimezoneOffset        string                 `json:"host_timezone_offset,omitempty"`
+	IgnoreLatestOf            string                 `json:"ignore_latest_of,omitempty"`
+	IsCluster                 bool                   `json:"is_cluster,omitempty"`
+	IsMultiZones              bool                   `json:"is_multi_zones,omitempty"`
+	JsonarUID                 string                 `json:"jsonar_uid,omitempty"`
+	JsonarUIDDisplayName      string                 `json:"jsonar_uid_display_name,omitempty"`
+	Location                  string                 `json:"location,omitempty"`
+	LogBucketID               string                 `json:"log_bucket_id,omitempty"`
+	LogsDestinationAssetID    string                 `json:"logs_destination_asset_id,omitempty"`
+	Logstore                  string                 `json:"logstore,omitempty"`
+	ManagedBy                 string                 `json:"managed_by,omitempty"`
+	MarkerAlias               string                 `json:"marker_alias,omitempty"`
+	MaxConcurrentConn         string                 `json:"max_concurrent_conn,omitempty"`
+	OwnedBy                   string                 `json:"owned_by,omitempty"`
+	Project                   string                 `json:"project,omitempty"`
+	S3Provider                string                 `json:"provider,omitempty"`
+	ProviderUrl               string                 `json:"provider_url,omitempty"`
+	Proxy                     string                 `json:"proxy,omitempty"`
+	PubsubSubscription        string                 `json:"pubsub_subscription,omitempty"`
+	PullType                  string                 `json:"pull_type,omitempty"`
+	Region                    string                 `json:"region,omitempty"`
+	ResourceID                string                 `json:"resource_id,omitempty"`
+	SdmEnabled                bool                   `json:"sdm_enabled,omitempty"`
+	Searches                  []interface{}          `json:"searches,omitempty"`
+	ServerHostName            string                 `json:"Server Host Name,omitempty"`
+	ServerIP                  string                 `json:"Server IP,omitempty"`
+	ServerPort                interface{}            `json:"Server Port,omitempty"`
+	ServiceEndpoint           string                 `json:"service_endpoint,omitempty"`
+	ServiceEndpoints          *ServiceEndpoints      `json:"service_endpoints,omitempty"`
+	ServiceName               string                 `json:"Service Name,omitempty"`
+	Ssl                       bool                   `json:"SSL,omitempty"`
+	SubscriptionID            string                 `json:"subscription_id,omitempty"`
+	UsedFor                   string                 `json:"used_for,omitempty"`
+	Version                   float64                `json:"version,omitempty"`
+	VirtualHostname           string                 `json:"virtual_hostname,omitempty"`
+	VirtualIP                 string                 `json:"virtual_ip,omitempty"`
+	XelDirectory              string                 `json:"xel_directory,omitempty"`
+	Extra                     map[string]interface{} `json:"-"` // keys of assetData that no field models, from extra_asset_data or read from the hub
+}
+
+// ConnectionData is the connectionData of an asset connection
+type ConnectionData struct {
+	AccessID                       string                 `json:"access_id,omitempty"`
+	AccessKey                      string                 `json:"access_key,omitempty"`
+	AccountName                    string                 `json:"account_name,omitempty"`
+	AmazonSecret                   *Secret                `json:"amazon_secret,omitempty"`
+	ApiKey                         string                 `json:"api_key,omitempty"`
+	ApplicationID                  string                 `json:"application_id,omitempty"`
+	AuthMechanism                  string                 `json:"auth_mechanism,omitempty"`
+	Autocommit                     bool                   `json:"autocommit,omitempty"`
+	AwsConnectionID                string                 `json:"aws_connection_id,omitempty"`
+	AwsIamServerID                 string                 `json:"aws_iam_server_id,omitempty"`
+	AzureStorageAccount            string                 `json:"azure_storage_account,omitempty"`
+	AzureStorageContainer          string                 `json:"azure_storage_container,omitempty"`
+	AzureStorageSecretKey          string                 `json:"azure_storage_secret_key,omitempty"`
+	Bucket                         string                 `json:"bucket,omitempty"`
+	CaCertsPath                    string                 `json:"ca_certs_path,omitempty"`
+	CaFile                         string                 `json:"ca_file,omitempty"`
+	CacheFile                      string                 `json:"cache_file,omitempty"`
+	CertFile                       string                 `json:"cert_file,omitempty"`
+	ClientID                       string                 `json:"client_id,omitempty"`
+	ClientSecret                   string                 `json:"client_secret,omitempty"`
+	ClusterID                      string                 `json:"cluster_id,omitempty"`
+	ClusterMemberID                string                 `json:"cluster_member_id,omitempty"`
+	ClusterName                    string                 `json:"cluster_name,omitempty"`
+	ContentType                    string                 `json:"content_type,omitempty"`
+	Crn                            string                 `json:"crn,omitempty"`
+	CyberarkSecret                 *Secret                `json:"cyberark_secret,omitempty"`
+	DatabaseName                   string                 `json:"database_name,omitempty"`
+	DbRole                         string                 `json:"db_role,omitempty"`
+	DirectoryID                    string                 `json:"directory_id,omitempty"`
+	Dn                             string                 `json:"DN,omitempty"`
+	DnsSrv                         bool                   `json:"DNS SRV,omitempty"`
+	Driver                         string                 `json:"driver,omitempty"`
+	Dsn                            string                 `json:"DSN,omitempty"`
+	EventhubAccessKey              string                 `json:"eventhub_access_key,omitempty"`
+	EventhubAccessPolicy           string                 `json:"eventhub_access_policy,omitempty"`
+	EventhubName                   string                 `json:"eventhub_name,omitempty"`
+	EventhubNamespace              string                 `json:"eventhub_namespace,omitempty"`
+	External                       bool                   `json:"external,omitempty"`
+	ExternalID                     string                 `json:"external_id,omitempty"`
+	ExtraKinitParameters           string                 `json:"extra_kinit_parameters,omitempty"`
+	Format                         string                 `json:"format,omitempty"`
+	HashicorpSecret                *Secret                `json:"hashicorp_secret,omitempty"`
+	Headers                        []interface{}          `json:"headers,omitempty"`
+	HiveServerType                 string                 `json:"Hive Server Type,omitempty"`
+	HostNameMismatch               bool                   `json:"host_name_mismatch,omitempty"`
+	Hosts                          string                 `json:"hosts,omitempty"`
+	Httppath                       string                 `json:"httppath,omitempty"`
+	IsCluster                      bool                   `json:"is_cluster,omitempty"`
+	JdbcSslTrustServerCertificate  bool                   `json:"jdbc_ssl_trust_server_certificate,omitempty"`
+	JdbcSslTrustStoreLocation      string                 `json:"jdbc_ssl_trust_store_location,omitempty"`
+	JdbcSslTrustStorePassword      string                 `json:"jdbc_ssl_trust_store_password,omitempty"`
+	KerberosHostFqdn               string                 `json:"kerberos_host_FQDN,omitempty"`
+	KerberosKdc                    string                 `json:"kerberos_kdc,omitempty"`
+	KerberosRetryCount             int                    `json:"kerberos_retry_count,omitempty"`
+	KerberosServiceKdc             string                 `json:"kerberos_service_kdc,omitempty"`
+	KerberosServiceRealm           string                 `json:"kerberos_service_realm,omitempty"`
+	KerberosSpn                    string                 `json:"kerberos_spn,omitempty"`
+	KeyFile                        string                 `json:"key_file,omitempty"`
+	KeytabFile                     string                 `json:"keytab_file,omitempty"`
+	KinitProgramPath               string                 `json:"kinit_program_path,omitempty"`
+	Namespace                      string                 `json:"namespace,omitempty"`
+	NetServiceName                 string                 `json:"net_service_name,omitempty"`
+	Nonce                          string                 `json:"nonce,omitempty"`
+	OauthParameters                map[string]string      `json:"oauth_parameters,omitempty"`
+	OdbcConnectionString           string                 `json:"odbc_connection_string,omitempty"`
+	Passphrase                     string                 `json:"passphrase,omitempty"`
+	Password                       string                 `json:"password,omitempty"`
+	Port                           string                 `json:"port,omitempty"` // TODO
+	Principal                      string                 `json:"principal,omitempty"`
+	ProjectID                      string                 `json:"project_id,omitempty"`
+	Protocol                       string                 `json:"protocol,omitempty"`
+	ProxyAutoDetect                string                 `json:"proxy_auto_detect,omitempty"`
+	ProxyPassword                  string                 `json:"proxy_password,omitempty"`
+	ProxyPort                      string                 `json:"proxy_port,omitempty"`
+	ProxyServer                    string                 `json:"proxy_server,omitempty"`
+	ProxySslType                   string                 `json:"proxy_ssl_type,omitempty"`
+	Query                          string                 `json:"query,omitempty"`
+	RedirectUri                    string                 `json:"redirect_uri,omitempty"`
+	Region                         string                 `json:"region,omitempty"`
+	ReplicaSet                     string                 `json:"replica_set,omitempty"`
+	ResourceID                     string                 `json:"resource_id,omitempty"`
+	RoleName                       string                 `json:"role_name,omitempty"`
+	Schema                         string                 `json:"schema,omitempty"`
+	SecBeforeOperatingExpiredToken int                    `json:"sec_before_operating_expired_token,omitempty"`
+	SecretKey                      string                 `json:"secret_key,omitempty"`
+	SelfSigned                     bool                   `json:"self_signed,omitempty"`
+	SelfSignedCert                 bool                   `json:"self_signed_cert,omitempty"`
+	ServerIP                       string                 `json:"server_ip,omitempty"`
+	ServerPort                     int                    `json:"server_port,omitempty"`
+	ServiceKey                     string                 `json:"service_key,omitempty"`
+	SessionToken                   string                 `json:"session_token,omitempty"`
+	Sid                            string                 `json:"SID,omitempty"`
+	SnowflakeRole                  string                 `json:"snowflake_role,omitempty"`
+	Ssl                            bool                   `json:"SSL,omitempty"`
+	SslServerCert                  string                 `json:"ssl_server_cert,omitempty"`
+	StoreAwsCredentials            bool                   `json:"store_aws_credentials,omitempty"`
+	SubscriptionID                 string                 `json:"subscription_id,omitempty"`
+	TenantID                       string                 `json:"tenant_id,omitempty"`
+	ThriftTransport                int                    `json:"Thrift Transport,omitempty"`
+	TmpUser                        bool                   `json:"tmp_user,omitempty"`
+	Token                          string                 `json:"token,omitempty"`
+	TokenEndpoint                  string                 `json:"token_endpoint,omitempty"`
+	Transportmode                  string                 `json:"transportMode,omitempty"`
+	Url                            string                 `json:"url,omitempty"`
+	UseKeytab                      bool                   `json:"use_keytab,omitempty"`
+	Username                       string                 `json:"username,omitempty"`
+	UserIdentityClientID           string                 `json:"user_identity_client_id,omitempty"`
+	V2KeyEngine                    bool                   `json:"v2_key_engine,omitempty"`
+	VirtualHostname                string                 `json:"virtual_hostname,omitempty"`
+	VirtualIP                      string                 `json:"virtual_ip,omitempty"`
+	WalletDir                      string                 `json:"wallet_dir,omitempty"`
+	Warehouse                      string                 `json:"warehouse,omitempty"`
+	Extra                          map[string]interface{} `json:"-"` // keys of connectionData that no field models, from extra_connection_data or read from the hub
+}
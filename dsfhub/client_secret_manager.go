@@ -41,7 +41,7 @@ func (c *Client) CreateSecretManager(secretManager ResourceWrapper) (*ResourceWr
 		return nil, fmt.Errorf("error parsing add SecretManager JSON response serverType: %s and gatewayID: %s | err: %s\n", secretManager.Data.ServerType, secretManager.Data.GatewayID, err)
 	}
 	if createSecretManagerResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(createSecretManagerResponse.Errors, responseBody)
 	}
 	return &createSecretManagerResponse, nil
 }
@@ -70,7 +70,7 @@ func (c *Client) ReadSecretManager(secretManagerId string) (*ResourceWrapper, er
 		return nil, fmt.Errorf("error parsing SecretManager JSON response for secretManagerId: %s | secretManager: %s err: %s\n", secretManagerId, responseBody, err)
 	}
 	if readSecretManagerResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readSecretManagerResponse.Errors, responseBody)
 	}
 
 	return &readSecretManagerResponse, nil
@@ -99,7 +99,7 @@ func (c *Client) ReadSecretManagers() (*ResourcesWrapper, error) {
 		return nil, fmt.Errorf("error parsing SecretManagers JSON response: %s err: %s\n", responseBody, err)
 	}
 	if readSecretManagersResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(readSecretManagersResponse.Errors, responseBody)
 	}
 
 	return &readSecretManagersResponse, nil
@@ -135,7 +135,7 @@ func (c *Client) UpdateSecretManager(secretManagerId string, secretManager Resou
 		return nil, fmt.Errorf("error parsing update SecretManager JSON response for secretManagerId: %s | err: %s\n", secretManagerId, err)
 	}
 	if updateSecretManagerResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(updateSecretManagerResponse.Errors, responseBody)
 	}
 
 	return &updateSecretManagerResponse, nil
@@ -165,7 +165,7 @@ func (c *Client) DeleteSecretManager(secretManagerId string) (*ResourceResponse,
 		return nil, fmt.Errorf("error parsing delete SecretManager JSON response for dataSourceId: %s, %s\n", secretManagerId, err)
 	}
 	if deleteSecretManagerResponse.Errors != nil {
-		return nil, fmt.Errorf("errors found in json response: %s", responseBody)
+		return nil, newAPIResponseError(deleteSecretManagerResponse.Errors, responseBody)
 	}
 
 	return &deleteSecretManagerResponse, nil
@@ -18,12 +18,51 @@ type Config struct {
 
 	// Params including syncType
 	Params map[string]string
+
+	// StrictAudit reports audit state verification failures as errors
+	StrictAudit bool
+
+	// UnusedConnectionFields determines how asset_connection fields that have
+	// no effect for the auth_mechanism are reported
+	UnusedConnectionFields string
+
+	// AssetDefinitionsSource determines where the asset schema and the
+	// required fields of each server type are loaded from: embedded, hub or
+	// file
+	AssetDefinitionsSource string
+
+	// AssetDefinitionsFile is the json file the asset definitions are loaded
+	// from when AssetDefinitionsSource is file
+	AssetDefinitionsFile string
+
+	// UpdateConflictCheck fails an update when the asset was changed on the
+	// hub since it was last read
+	UpdateConflictCheck bool
+
+	// AdoptExisting updates an existing asset with the same asset_id instead
+	// of failing to create it
+	AdoptExisting bool
+
+	// AdoptManagedBy is written to managed_by of the adopted assets that do
+	// not configure it
+	AdoptManagedBy string
+
+	// WorkspaceID is written to OwnershipField of the assets created by
+	// Terraform, which refuses to update or delete assets marked otherwise
+	WorkspaceID string
+
+	// OwnershipField is the assetData field of the ownership marker
+	OwnershipField string
 }
 
 var missingAPITokenMessage = "DSF HUB API Token must be provided"
 var missingDSFHostMessage = "DSF HUB host/API endpoint must be provided"
 var validSyncTypes = []string{"SYNC_GW_BLOCKING", "SYNC_GW_NON_BLOCKING", "DO_NOT_SYNC_GW"}
 var invalidSyncTypeMessage = "Invalid sync_type. Available values: " + strings.Join(validSyncTypes, ", ")
+var validUnusedConnectionFields = []string{"warn", "error", "ignore"}
+var invalidUnusedConnectionFieldsMessage = "Invalid unused_connection_fields. Available values: " + strings.Join(validUnusedConnectionFields, ", ")
+var invalidAssetDefinitionsSourceMessage = "Invalid asset_definitions_source. Available values: " + strings.Join(assetDefinitionsSources, ", ")
+var missingAssetDefinitionsFileMessage = "asset_definitions_file must be provided when asset_definitions_source is file"
 
 // Client configures and returns a fully initialized DSF Client
 func (c *Config) Client() (interface{}, error) {
@@ -42,6 +81,24 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	// Check unused_connection_fields
+	if c.UnusedConnectionFields != "" && !contains(validUnusedConnectionFields, c.UnusedConnectionFields) {
+		return nil, errors.New(invalidUnusedConnectionFieldsMessage)
+	}
+
+	// Check asset_definitions_source
+	if c.AssetDefinitionsSource != "" && !contains(assetDefinitionsSources, c.AssetDefinitionsSource) {
+		return nil, errors.New(invalidAssetDefinitionsSourceMessage)
+	}
+	if c.AssetDefinitionsSource == "file" && strings.TrimSpace(c.AssetDefinitionsFile) == "" {
+		return nil, errors.New(missingAssetDefinitionsFileMessage)
+	}
+
+	// Check ownership_field
+	if err := validateOwnershipField(c.ownershipField()); err != nil {
+		return nil, err
+	}
+
 	// Create client
 	client := NewClient(c)
 
@@ -52,6 +109,11 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	// Load the asset definitions
+	if err := client.loadAssetDefinitions(); err != nil {
+		return nil, err
+	}
+
 	return client, nil
 }
 
@@ -101,3 +101,28 @@ func TestInvalidSyncType(t *testing.T) {
 		t.Errorf("Should have invalid sync_type message, got: %s", err)
 	}
 }
+
+func TestInvalidUnusedConnectionFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestInvalidUnusedConnectionFields \n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != "/dsf/api/v2/gateways" {
+			t.Errorf("Should have have hit /gateways endpoint. Got: %s", req.URL.String())
+		}
+		rw.Write([]byte(`{ "data": [ { "applianceId": 1, "applianceType": "DSF_HUB", "id": "a1b2c3-4d5e-6f7g-8h9i-9adf5a7d8a72-172.16.1.123", "name": "ba-dsf-4.12-hub", "hostname": "172.16.1.123", "serverType": "IMPERVA WAREHOUSE", "sonar": { "jsonarUid": "a1b2c3-4d5e-6f7g-8h9i-678910" } } ] }`))
+	}))
+	defer server.Close()
+
+	invalidUnusedConnectionFields := "fail"
+	log.Printf("[INFO] Configuring client with unused_connection_fields: '%v'\n", invalidUnusedConnectionFields)
+
+	config := Config{DSFHUBToken: "good", DSFHUBHost: server.URL, UnusedConnectionFields: invalidUnusedConnectionFields}
+	client, err := config.Client()
+	if err == nil {
+		t.Errorf("Should have received an error, got a client: %q", client)
+	}
+	if err.Error() != invalidUnusedConnectionFieldsMessage {
+		t.Errorf("Should have invalid unused_connection_fields message, got: %s", err)
+	}
+}
@@ -32,12 +32,18 @@ func dataSourceCloudAccountRead(ctx context.Context, d *schema.ResourceData, m i
 	log.Printf("[INFO] Data Source - Reading CloudAccount with cloudAccountId: %s", curCloudAccountId)
 
 	cloudAccountReadResponse, err := client.ReadCloudAccount(curCloudAccountId)
+	if err != nil {
+		log.Printf("[ERROR] Reading cloudAccountReadResponse | err: %s\n", err)
+		return diag.FromErr(err)
+	}
 	if cloudAccountReadResponse != nil {
 		log.Printf("[INFO] Reading CloudAcount with cloudAccountId: %s | err: %s\n", curCloudAccountId, err)
 	}
 	cloudAccountId := cloudAccountReadResponse.Data.AssetData.AssetID
-	d.Set("asset_id", cloudAccountId)
 	d.SetId(cloudAccountId)
+	if diags := flattenAsset(d, dataSourceCloudAccount().Schema, cloudAccountReadResponse.Data, client.assetDefinitions()); diags.HasError() {
+		return diags
+	}
 
 	log.Printf("[INFO] Finished reading Data Source CloudAccount with cloudAccountId: %s\n", cloudAccountId)
 	return nil
@@ -32,12 +32,18 @@ func dataSourceDSFDataSourceRead(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] DataSource - Reading DSFDataSource with dsfDataSourceId: %s", curDSFDataSourceId)
 
 	dsfDataSourceReadResponse, err := client.ReadDSFDataSource(curDSFDataSourceId)
+	if err != nil {
+		log.Printf("[ERROR] Reading dsfDataSourceReadResponse | err: %s\n", err)
+		return diag.FromErr(err)
+	}
 	if dsfDataSourceReadResponse != nil {
 		log.Printf("[INFO] Reading DSFDataSource with dsfDataSourceId: %s | err: %s\n", curDSFDataSourceId, err)
 	}
 	dsfDataSourceId := dsfDataSourceReadResponse.Data.AssetData.AssetID
-	d.Set("asset_id", dsfDataSourceId)
 	d.SetId(dsfDataSourceId)
+	if diags := flattenAsset(d, dataSourceDSFDataSource().Schema, dsfDataSourceReadResponse.Data, client.assetDefinitions()); diags.HasError() {
+		return diags
+	}
 
 	log.Printf("[INFO] Finished reading DataSource DSFDataSource with dsfDataSourceId: %s\n", dsfDataSourceId)
 	return nil
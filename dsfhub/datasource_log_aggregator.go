@@ -32,12 +32,18 @@ func dataSourceLogAggregatorRead(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] DataSource - Reading LogAggregator with secretManagerId: %s", curLogAggregatorId)
 
 	logAggregatorReadResponse, err := client.ReadLogAggregator(curLogAggregatorId)
+	if err != nil {
+		log.Printf("[ERROR] Reading logAggregatorReadResponse | err: %s\n", err)
+		return diag.FromErr(err)
+	}
 	if logAggregatorReadResponse != nil {
 		log.Printf("[INFO] Reading LogAggregator with logAggregatorId: %s | err: %s\n", curLogAggregatorId, err)
 	}
 	logAggregatorId := logAggregatorReadResponse.Data.AssetData.AssetID
-	d.Set("asset_id", logAggregatorId)
 	d.SetId(logAggregatorId)
+	if diags := flattenAsset(d, dataSourceLogAggregator().Schema, logAggregatorReadResponse.Data, client.assetDefinitions()); diags.HasError() {
+		return diags
+	}
 
 	log.Printf("[INFO] Finished reading DataSource LogAggregator with logAggregatorId: %s\n", logAggregatorId)
 	return nil
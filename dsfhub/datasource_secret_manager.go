@@ -32,12 +32,18 @@ func dataSourceSecretManagerRead(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] DataSource - Reading SecretManager with secretManagerId: %s", curSecretManagerId)
 
 	secretManagerReadResponse, err := client.ReadSecretManager(curSecretManagerId)
+	if err != nil {
+		log.Printf("[ERROR] Reading secretManagerReadResponse | err: %s\n", err)
+		return diag.FromErr(err)
+	}
 	if secretManagerReadResponse != nil {
 		log.Printf("[INFO] Reading SecretManager with secretManagerId: %s | err: %s\n", curSecretManagerId, err)
 	}
 	secretManagerId := secretManagerReadResponse.Data.AssetData.AssetID
-	d.Set("asset_id", secretManagerId)
 	d.SetId(secretManagerId)
+	if diags := flattenAsset(d, dataSourceSecretManager().Schema, secretManagerReadResponse.Data, client.assetDefinitions()); diags.HasError() {
+		return diags
+	}
 
 	log.Printf("[INFO] Finished reading DataSource SecretManager with secretManagerId: %s\n", secretManagerId)
 	return nil
@@ -0,0 +1,3 @@
+package dsfhub
+
+//go:generate go run ./internal/generate/assetschema -schema asset_schema.json -root ..
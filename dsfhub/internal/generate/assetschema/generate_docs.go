@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// docsMarker matches the lists of attributes generated in the registry docs,
+// e.g. <!-- generated:begin asset_connection.optional --> lists the optional
+// attributes of asset_connection
+var docsMarker = regexp.MustCompile(`(?s)<!-- generated:begin ([a-z_.]+) -->\n.*?<!-- generated:end -->`)
+
+// generateDocs returns the registry docs of r, with the lists of attributes
+// between markers in docs replaced by the attributes of the resource
+func generateDocs(s *canonicalSchema, r resource, docs []byte) ([]byte, error) {
+	attributes, err := s.resourceAttributes(r)
+	if err != nil {
+		return nil, err
+	}
+	lists := map[string][]string{}
+	addDocsLists(lists, "", attributes)
+
+	used := map[string]bool{}
+	generated := docsMarker.ReplaceAllStringFunc(string(docs), func(marker string) string {
+		key := docsMarker.FindStringSubmatch(marker)[1]
+		used[key] = true
+		return fmt.Sprintf("<!-- generated:begin %s -->\n%s\n<!-- generated:end -->", key, strings.Join(lists[key], "\n"))
+	})
+
+	for _, key := range sortedKeys(lists) {
+		if !used[key] {
+			return nil, fmt.Errorf("%s has no <!-- generated:begin %s --> list for %s", r.Docs, key, strings.Join(lists[key], ", "))
+		}
+	}
+	for _, key := range sortedKeys(used) {
+		if len(lists[key]) == 0 {
+			return nil, fmt.Errorf("%s has no attributes for the <!-- generated:begin %s --> list", r.Docs, key)
+		}
+	}
+	return []byte(generated), nil
+}
+
+// addDocsLists adds the docs of attributes at path to the list of their
+// section
+func addDocsLists(lists map[string][]string, path string, attributes map[string]*attribute) {
+	for _, name := range sortedKeys(attributes) {
+		a := attributes[name]
+		key := docsSection(a)
+		if path != "" {
+			key = path + "." + key
+		}
+
+		doc := a.Doc
+		if doc == "" {
+			doc = a.Description
+		}
+		lists[key] = append(lists[key], strings.TrimSpace(fmt.Sprintf("- `%s` - (%s) %s", name, docsType(a), doc)))
+		if a.WriteOnlyVariant {
+			lists[key] = append(lists[key],
+				fmt.Sprintf("- `%[1]s_wo` - (String, Write-only) Write-only variant of `%[1]s`, sent to the DSF Hub but never stored in the Terraform state. Requires Terraform 1.11 or later. Only one of `%[1]s` and `%[1]s_wo` can be set.", name),
+				fmt.Sprintf("- `%[1]s_wo_version` - (Number) Version of `%[1]s_wo`. Since `%[1]s_wo` is not stored in the state, change `%[1]s_wo_version` to send a new value of `%[1]s_wo` to the DSF Hub.", name),
+			)
+		}
+
+		if a.Block != nil {
+			childPath := name
+			if path != "" {
+				childPath = path + "." + name
+			}
+			addDocsLists(lists, childPath, a.Block)
+		}
+	}
+}
+
+// docsSection returns the list of the registry docs with the attribute
+func docsSection(a *attribute) string {
+	switch {
+	case a.DocSection != "":
+		return a.DocSection
+	case a.Required:
+		return "required"
+	case !a.Optional && a.Computed:
+		return "attributes"
+	default:
+		return "optional"
+	}
+}
+
+// docsType returns the type of an attribute in the registry docs
+func docsType(a *attribute) string {
+	names := map[string]string{
+		"bool":   "Boolean",
+		"float":  "Number",
+		"int":    "Number",
+		"string": "String",
+	}
+	switch a.Type {
+	case "list", "set":
+		if a.Block != nil {
+			return "Block"
+		}
+		return fmt.Sprintf("%s of %s", map[string]string{"list": "List", "set": "Set"}[a.Type], strings.ToLower(names[a.Elem]))
+	case "map":
+		return fmt.Sprintf("Map of %s", strings.ToLower(names[a.Elem]))
+	}
+	return names[a.Type]
+}
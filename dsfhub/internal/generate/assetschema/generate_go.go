@@ -0,0 +1,260 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// generatedHeader starts every generated Go file
+const generatedHeader = "// Code generated by internal/generate/assetschema from asset_schema.json; DO NOT EDIT.\n\npackage dsfhub\n\n"
+
+// generateAssetSchema returns asset_schema_gen.go: the definitions of the hub
+// fields and the required fields of each server type
+func generateAssetSchema(s *canonicalSchema) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+
+	authMechanisms, err := jsonLiteral(map[string]interface{}{"auth_mechanisms": s.AuthMechanisms})
+	if err != nil {
+		return nil, err
+	}
+	b.WriteString(`// authMechanismAllowedFieldsJson lists, for each auth_mechanism, the
+// credential fields of an asset_connection that are used by the hub in
+// addition to the fields required for the server type. The server types of
+// the required fields json list the fields allowed for each of their
+// auth_mechanisms in allowed_auth_mechanisms, this list is used for the server
+// types that do not, e.g. those loaded from the hub. Connection fields not
+// listed for any auth_mechanism, e.g. database_name or ssl, are not checked.
+`)
+	fmt.Fprintf(&b, "var authMechanismAllowedFieldsJson = %s\n\n", authMechanisms)
+
+	hubFields := func(fields []field) map[string]interface{} {
+		entries := map[string]interface{}{}
+		for _, f := range fields {
+			if f.Hub == nil {
+				continue
+			}
+			entry := map[string]interface{}{"id": f.ID}
+			for k, v := range f.Hub {
+				entry[k] = v
+			}
+			entries[f.Name] = entry
+		}
+		return entries
+	}
+	assetSchema, err := jsonLiteral(map[string]interface{}{
+		"connections": hubFields(s.Connections),
+		"details":     hubFields(s.Details),
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.WriteString("// assetSchemaJson describes the fields of assets and asset connections by\n// their name in AssetData and ConnectionData\n")
+	fmt.Fprintf(&b, "var assetSchemaJson = %s\n", assetSchema)
+
+	for _, r := range s.Resources {
+		required, err := jsonLiteral(map[string]interface{}{"ServerTypes": r.ServerTypes})
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "\n// %s lists, for each server type of %s, the required\n// fields and the asset_connection fields required for each auth_mechanism\n", r.RequiredFieldsVar, r.Name)
+		fmt.Fprintf(&b, "var %s = %s\n", r.RequiredFieldsVar, required)
+
+		fmt.Fprintf(&b, "\n// %s lists, for each server type of %s, the\n// required fields that are not checked\n", r.IgnoreParamsVar, r.Name)
+		fmt.Fprintf(&b, "var %s = map[string]map[string]bool{\n", r.IgnoreParamsVar)
+		for _, serverType := range sortedKeys(r.IgnoreParamsByServerType) {
+			var params []string
+			for _, param := range sortedKeys(r.IgnoreParamsByServerType[serverType]) {
+				params = append(params, fmt.Sprintf("%q: %t", param, r.IgnoreParamsByServerType[serverType][param]))
+			}
+			fmt.Fprintf(&b, "%q: {%s},\n", serverType, strings.Join(params, ", "))
+		}
+		b.WriteString("}\n")
+	}
+
+	var writeOnly []string
+	for _, f := range s.Connections {
+		for _, r := range s.Resources {
+			if _, ok := f.Resources[r.Name]; !ok {
+				continue
+			}
+			a, err := decodeAttribute(mergeProperties(f.Attribute, f.Resources[r.Name]))
+			if err != nil {
+				return nil, err
+			}
+			if a.WriteOnlyVariant {
+				writeOnly = append(writeOnly, f.ID)
+				break
+			}
+		}
+	}
+	sort.Strings(writeOnly)
+	b.WriteString(`
+// writeOnlyCredentialFields lists the asset_connection credentials that have a
+// write-only variant, named after the credential with a _wo suffix. The value
+// of a write-only credential is only read from the configuration and never
+// stored in the state, so changing it requires a change to its _wo_version
+// companion attribute.
+`)
+	b.WriteString("var writeOnlyCredentialFields = []string{\n")
+	for _, name := range writeOnly {
+		fmt.Fprintf(&b, "%q,\n", name)
+	}
+	b.WriteString("}\n")
+
+	return formatSource(b.Bytes())
+}
+
+// generatePayload returns client_payload_gen.go: the AssetData and
+// ConnectionData structs sent to and read from the hub
+func generatePayload(s *canonicalSchema) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	for _, st := range []struct {
+		name, doc string
+		fields    []field
+	}{
+		{"AssetData", "AssetData is the assetData of an asset", s.Details},
+		{"ConnectionData", "ConnectionData is the connectionData of an asset connection", s.Connections},
+	} {
+		fmt.Fprintf(&b, "// %s\ntype %s struct {\n", st.doc, st.name)
+		for _, f := range st.fields {
+			if f.GoType == "" {
+				continue
+			}
+			fmt.Fprintf(&b, "%s %s `json:%q`", f.Name, f.GoType, f.JSON)
+			if f.GoComment != "" {
+				fmt.Fprintf(&b, " // %s", f.GoComment)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("}\n\n")
+	}
+	return formatSource(b.Bytes())
+}
+
+// generateResourceSchemas returns resource_schema_gen.go: a function returning
+// the schema of each resource
+func generateResourceSchemas(s *canonicalSchema) ([]byte, error) {
+	var body bytes.Buffer
+	for _, r := range s.Resources {
+		attributes, err := s.resourceAttributes(r)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&body, "\n// %s returns the attributes of %s\nfunc %s() map[string]*schema.Schema {\nreturn ", r.SchemaFunc, r.Name, r.SchemaFunc)
+		writeSchemaMap(&body, attributes)
+		body.WriteString("\n}\n")
+	}
+
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("import (\n")
+	b.WriteString("\"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema\"\n")
+	if bytes.Contains(body.Bytes(), []byte("validation.")) {
+		b.WriteString("\"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation\"\n")
+	}
+	b.WriteString(")\n")
+	b.Write(body.Bytes())
+	return formatSource(b.Bytes())
+}
+
+// writeSchemaMap writes the map[string]*schema.Schema literal of attributes
+func writeSchemaMap(b *bytes.Buffer, attributes map[string]*attribute) {
+	b.WriteString("map[string]*schema.Schema{\n")
+	for _, name := range sortedKeys(attributes) {
+		a := attributes[name]
+		fmt.Fprintf(b, "%q: {\n", name)
+		fmt.Fprintf(b, "Type: %s,\n", attributeTypes[a.Type])
+		fmt.Fprintf(b, "Description: %q,\n", a.Description)
+		for _, property := range []struct {
+			name  string
+			value bool
+		}{{"Required", a.Required}, {"Optional", a.Optional}, {"Computed", a.Computed}, {"ForceNew", a.ForceNew}, {"Sensitive", a.Sensitive}} {
+			if property.value {
+				fmt.Fprintf(b, "%s: true,\n", property.name)
+			}
+		}
+		if a.Default != nil {
+			fmt.Fprintf(b, "Default: %s,\n", goLiteral(a.Default))
+		}
+		if a.MinItems != 0 {
+			fmt.Fprintf(b, "MinItems: %d,\n", a.MinItems)
+		}
+		if a.MaxItems != 0 {
+			fmt.Fprintf(b, "MaxItems: %d,\n", a.MaxItems)
+		}
+		for _, property := range []struct{ name, value string }{
+			{"ValidateFunc", a.ValidateFunc},
+			{"ValidateDiagFunc", a.ValidateDiagFunc},
+			{"DiffSuppressFunc", a.DiffSuppressFunc},
+			{"Set", a.SetFunc},
+		} {
+			if property.value != "" {
+				fmt.Fprintf(b, "%s: %s,\n", property.name, property.value)
+			}
+		}
+		switch {
+		case a.Block != nil:
+			b.WriteString("Elem: &schema.Resource{\nSchema: ")
+			writeSchemaMap(b, a.Block)
+			b.WriteString(",\n},\n")
+		case a.Elem != "":
+			fmt.Fprintf(b, "Elem: &schema.Schema{\nType: %s,\n},\n", attributeTypes[a.Elem])
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("}")
+}
+
+// goLiteral returns the Go literal of a default value
+func goLiteral(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// jsonLiteral returns v as indented json in a Go string literal
+func jsonLiteral(v interface{}) (string, error) {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+	s := strings.TrimSuffix(b.String(), "\n")
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s), nil
+	}
+	return "`" + s + "`", nil
+}
+
+// formatSource formats generated Go source
+func formatSource(src []byte) ([]byte, error) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w\n%s", err, src)
+	}
+	return formatted, nil
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,65 @@
+// Command assetschema generates the asset schemas, payload structs and
+// registry docs of the provider from asset_schema.json.
+//
+// It is run by go generate in the dsfhub package:
+//
+//	go generate ./dsfhub
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "asset_schema.json", "path of the canonical asset schema")
+	outDir := flag.String("out", ".", "directory of the generated Go files")
+	root := flag.String("root", "..", "root of the repository, containing the registry docs")
+	flag.Parse()
+
+	s, err := loadSchema(*schemaPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := generate(s, *outDir, *root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the content of the generated files by path
+func generate(s *canonicalSchema, outDir string, root string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for name, generator := range map[string]func(*canonicalSchema) ([]byte, error){
+		"asset_schema_gen.go":    generateAssetSchema,
+		"client_payload_gen.go":  generatePayload,
+		"resource_schema_gen.go": generateResourceSchemas,
+	} {
+		content, err := generator(s)
+		if err != nil {
+			return nil, err
+		}
+		files[filepath.Join(outDir, name)] = content
+	}
+
+	for _, r := range s.Resources {
+		path := filepath.Join(root, r.Docs)
+		docs, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		content, err := generateDocs(s, r, docs)
+		if err != nil {
+			return nil, err
+		}
+		files[path] = content
+	}
+	return files, nil
+}
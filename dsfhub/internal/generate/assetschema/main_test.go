@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestGeneratedFilesAreUpToDate fails when asset_schema.json was changed
+// without running go generate
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	s, err := loadSchema("../../../asset_schema.json")
+	if err != nil {
+		t.Fatalf("Should have loaded the schema: %s", err)
+	}
+	files, err := generate(s, "../../..", "../../../..")
+	if err != nil {
+		t.Fatalf("Should have generated the files: %s", err)
+	}
+	for path, expected := range files {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Errorf("Should have read %s: %s", path, err)
+			continue
+		}
+		if !bytes.Equal(got, expected) {
+			t.Errorf("%s is not up to date, run go generate ./dsfhub", path)
+		}
+	}
+}
+
+func TestMergeProperties(t *testing.T) {
+	merged := mergeProperties(
+		map[string]interface{}{"type": "string", "optional": true, "computed": true},
+		map[string]interface{}{"computed": nil, "description": "Name"},
+	)
+	if len(merged) != 3 || merged["type"] != "string" || merged["optional"] != true || merged["description"] != "Name" {
+		t.Errorf("Should have merged the properties. Got: %v", merged)
+	}
+}
+
+func TestAttributeValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		attribute attribute
+		valid     bool
+	}{
+		{"string", attribute{Type: "string"}, true},
+		{"list of string", attribute{Type: "list", Elem: "string"}, true},
+		{"list without elem", attribute{Type: "list"}, false},
+		{"map without elem", attribute{Type: "map"}, false},
+		{"string with elem", attribute{Type: "string", Elem: "string"}, false},
+		{"unknown type", attribute{Type: "object"}, false},
+		{"unknown doc section", attribute{Type: "string", DocSection: "other"}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.attribute.validate("name"); (err == nil) != tc.valid {
+				t.Errorf("Should have validated %v as %t. Got: %v", tc.attribute, tc.valid, err)
+			}
+		})
+	}
+}
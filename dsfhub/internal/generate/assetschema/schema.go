@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// canonicalSchema is the single definition of the assets of the provider, read
+// from asset_schema.json
+type canonicalSchema struct {
+	// AuthMechanisms lists, for each auth_mechanism, the credential fields of
+	// an asset_connection used by the hub
+	AuthMechanisms map[string][]string `json:"auth_mechanisms"`
+	// Details are the fields of an asset, in the order of AssetData
+	Details []field `json:"details"`
+	// Connections are the fields of an asset_connection, in the order of
+	// ConnectionData
+	Connections []field `json:"connections"`
+	// Resources are the resources managing assets
+	Resources []resource `json:"resources"`
+}
+
+// field is a field of an asset or of an asset_connection
+type field struct {
+	// Name is the name of the field in the Go payload structs and its key in
+	// assetSchemaJson
+	Name string `json:"name"`
+	// ID is the name of the attribute of the field
+	ID string `json:"id,omitempty"`
+	// GoType is the type of the field in AssetData or ConnectionData, the
+	// field is not in the struct when empty
+	GoType string `json:"go_type,omitempty"`
+	// JSON is the json tag of the field in AssetData or ConnectionData
+	JSON string `json:"json,omitempty"`
+	// GoComment is a comment added after the field in the struct
+	GoComment string `json:"go_comment,omitempty"`
+	// Hub is the definition of the field by the hub, its entry in
+	// assetSchemaJson without the id
+	Hub map[string]interface{} `json:"hub,omitempty"`
+	// Attribute is the attribute of the field
+	Attribute map[string]interface{} `json:"attribute,omitempty"`
+	// Resources are the resources having the attribute, with the changes to
+	// Attribute for that resource. A null value removes a property.
+	Resources map[string]map[string]interface{} `json:"resources,omitempty"`
+}
+
+// resource is a resource managing assets
+type resource struct {
+	// Name is the name of the resource, e.g. dsfhub_data_source
+	Name string `json:"name"`
+	// SchemaFunc is the name of the generated function returning its schema
+	SchemaFunc string `json:"schema_func"`
+	// RequiredFieldsVar is the name of the generated variable with ServerTypes
+	RequiredFieldsVar string `json:"required_fields_var"`
+	// IgnoreParamsVar is the name of the generated variable with
+	// IgnoreParamsByServerType
+	IgnoreParamsVar string `json:"ignore_params_var"`
+	// Docs is the registry docs of the resource, relative to the root of the
+	// repository
+	Docs string `json:"docs"`
+	// Attributes are the attributes of the resource that are not fields of
+	// the asset, e.g. provider settings and asset_connection
+	Attributes map[string]map[string]interface{} `json:"attributes"`
+	// IgnoreParamsByServerType are the required fields that are not checked
+	// for a server type
+	IgnoreParamsByServerType map[string]map[string]bool `json:"ignore_params_by_server_type"`
+	// ServerTypes are, for each server type, the required fields, the
+	// asset_connection fields required and allowed for each auth_mechanism and
+	// the values allowed for fields like audit_type that the hub restricts per
+	// server type
+	ServerTypes map[string]interface{} `json:"server_types"`
+}
+
+// attribute is an attribute of a resource, the properties of schema.Schema.
+// Properties holding functions are Go expressions.
+type attribute struct {
+	Type             string                `json:"type"`
+	Elem             string                `json:"elem,omitempty"`
+	Block            map[string]*attribute `json:"block,omitempty"`
+	Description      string                `json:"description,omitempty"`
+	Required         bool                  `json:"required,omitempty"`
+	Optional         bool                  `json:"optional,omitempty"`
+	Computed         bool                  `json:"computed,omitempty"`
+	ForceNew         bool                  `json:"force_new,omitempty"`
+	Sensitive        bool                  `json:"sensitive,omitempty"`
+	Default          interface{}           `json:"default,omitempty"`
+	MinItems         int                   `json:"min_items,omitempty"`
+	MaxItems         int                   `json:"max_items,omitempty"`
+	ValidateFunc     string                `json:"validate_func,omitempty"`
+	ValidateDiagFunc string                `json:"validate_diag_func,omitempty"`
+	DiffSuppressFunc string                `json:"diff_suppress_func,omitempty"`
+	SetFunc          string                `json:"set_func,omitempty"`
+	// WriteOnlyVariant adds <name>_wo and <name>_wo_version attributes to
+	// asset_connection
+	WriteOnlyVariant bool `json:"write_only_variant,omitempty"`
+	// Doc is the description of the attribute in the registry docs, defaults
+	// to Description
+	Doc string `json:"doc,omitempty"`
+	// DocSection is the list of the registry docs with the attribute:
+	// required, optional or attributes. Defaults to required for required
+	// attributes, attributes for computed ones and optional otherwise.
+	DocSection string `json:"doc_section,omitempty"`
+}
+
+var attributeTypes = map[string]string{
+	"bool":   "schema.TypeBool",
+	"float":  "schema.TypeFloat",
+	"int":    "schema.TypeInt",
+	"list":   "schema.TypeList",
+	"map":    "schema.TypeMap",
+	"set":    "schema.TypeSet",
+	"string": "schema.TypeString",
+}
+
+// loadSchema reads the canonical schema from path
+func loadSchema(path string) (*canonicalSchema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+	var s canonicalSchema
+	if err := decoder.Decode(&s); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &s, s.validate()
+}
+
+// validate checks the references between the fields and the resources
+func (s *canonicalSchema) validate() error {
+	names := map[string]bool{}
+	for _, r := range s.Resources {
+		names[r.Name] = true
+	}
+	for _, fields := range [][]field{s.Details, s.Connections} {
+		seen := map[string]bool{}
+		for _, f := range fields {
+			if seen[f.Name] {
+				return fmt.Errorf("field %s is defined twice", f.Name)
+			}
+			seen[f.Name] = true
+			if f.Hub != nil && f.ID == "" {
+				return fmt.Errorf("field %s has no id", f.Name)
+			}
+			if len(f.Resources) > 0 && f.Attribute == nil {
+				return fmt.Errorf("field %s has resources but no attribute", f.Name)
+			}
+			for name := range f.Resources {
+				if !names[name] {
+					return fmt.Errorf("field %s has an attribute in unknown resource %s", f.Name, name)
+				}
+			}
+		}
+	}
+	for _, r := range s.Resources {
+		if _, err := s.resourceAttributes(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceAttributes returns the attributes of a resource, with the
+// asset_connection attributes in the block of asset_connection
+func (s *canonicalSchema) resourceAttributes(r resource) (map[string]*attribute, error) {
+	attributes := map[string]*attribute{}
+	for name, properties := range r.Attributes {
+		a, err := decodeAttribute(properties)
+		if err != nil {
+			return nil, fmt.Errorf("%s attribute %s: %w", r.Name, name, err)
+		}
+		attributes[name] = a
+	}
+	if err := addFieldAttributes(attributes, s.Details, r.Name); err != nil {
+		return nil, err
+	}
+
+	if connection, ok := attributes["asset_connection"]; ok {
+		connection.Block = map[string]*attribute{}
+		if err := addFieldAttributes(connection.Block, s.Connections, r.Name); err != nil {
+			return nil, err
+		}
+	}
+	for name, a := range attributes {
+		if err := a.validate(name); err != nil {
+			return nil, fmt.Errorf("%s: %w", r.Name, err)
+		}
+	}
+	return attributes, nil
+}
+
+// addFieldAttributes adds to attributes the attributes of the fields that
+// resourceName has
+func addFieldAttributes(attributes map[string]*attribute, fields []field, resourceName string) error {
+	for _, f := range fields {
+		changes, ok := f.Resources[resourceName]
+		if !ok {
+			continue
+		}
+		if _, found := attributes[f.ID]; found {
+			return fmt.Errorf("%s attribute %s is defined twice", resourceName, f.ID)
+		}
+		a, err := decodeAttribute(mergeProperties(f.Attribute, changes))
+		if err != nil {
+			return fmt.Errorf("%s attribute %s: %w", resourceName, f.ID, err)
+		}
+		attributes[f.ID] = a
+	}
+	return nil
+}
+
+// mergeProperties returns the properties of base changed by changes, nested
+// properties are merged and null values remove a property
+func mergeProperties(base map[string]interface{}, changes map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range changes {
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+		baseMap, baseOk := merged[k].(map[string]interface{})
+		changesMap, changesOk := v.(map[string]interface{})
+		if baseOk && changesOk {
+			merged[k] = mergeProperties(baseMap, changesMap)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// decodeAttribute decodes the properties of an attribute
+func decodeAttribute(properties map[string]interface{}) (*attribute, error) {
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+	var a attribute
+	if err := decoder.Decode(&a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// validate checks the properties of the attribute name
+func (a *attribute) validate(name string) error {
+	if _, ok := attributeTypes[a.Type]; !ok {
+		return fmt.Errorf("attribute %s has unknown type %q", name, a.Type)
+	}
+	switch a.Type {
+	case "list", "set":
+		if (a.Elem == "") == (a.Block == nil) {
+			return fmt.Errorf("attribute %s of type %s must have either elem or block", name, a.Type)
+		}
+	case "map":
+		if a.Elem == "" || a.Block != nil {
+			return fmt.Errorf("attribute %s of type map must have elem", name)
+		}
+	default:
+		if a.Elem != "" || a.Block != nil {
+			return fmt.Errorf("attribute %s of type %s cannot have elem or block", name, a.Type)
+		}
+	}
+	if a.Elem != "" {
+		if _, ok := attributeTypes[a.Elem]; !ok {
+			return fmt.Errorf("attribute %s has unknown elem %q", name, a.Elem)
+		}
+	}
+	switch a.DocSection {
+	case "", "required", "optional", "attributes":
+	default:
+		return fmt.Errorf("attribute %s has unknown doc_section %q", name, a.DocSection)
+	}
+	for blockName, b := range a.Block {
+		if err := b.validate(name + "." + blockName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -27,6 +27,45 @@ func init() {
 			"SYNC_GW_NON_BLOCKING: The operation is asynchronous and returns immediately.\n" +
 			"DO_NOT_SYNC_GW: The operation is synchronous and does not update the gateways.\n" +
 			"Default: SYNC_GW_BLOCKING",
+
+		"strict_audit": "If true, failing to verify that audit collection was enabled or disabled on the DSF Hub for a data source or log aggregator " +
+			"is reported as an error instead of a warning. Can be set via STRICT_AUDIT environment variable.\n" +
+			"Default: false",
+
+		"unused_connection_fields": "Determines how asset_connection fields that have no effect for the auth_mechanism of the connection are reported. Available values:\n" +
+			"warn: A warning is reported for each field when the configuration is planned.\n" +
+			"error: The plan fails.\n" +
+			"ignore: The fields are not reported.\n" +
+			"Can be set via UNUSED_CONNECTION_FIELDS environment variable. Default: warn",
+
+		"asset_definitions_source": "Determines where the asset schema and the required fields of each server type, used to validate assets and build their payload, are loaded from. Available values:\n" +
+			"embedded: The definitions compiled into the provider are used.\n" +
+			"hub: The definitions are read from the DSF Hub and merged over the embedded definitions.\n" +
+			"file: The definitions are read from asset_definitions_file and merged over the embedded definitions.\n" +
+			"Can be set via ASSET_DEFINITIONS_SOURCE environment variable. Default: embedded",
+
+		"asset_definitions_file": "The JSON file the asset definitions are read from when asset_definitions_source is file. " +
+			"Can be set via ASSET_DEFINITIONS_FILE environment variable.",
+
+		"update_conflict_check": "If true, updating an asset fails when it was changed on the DSF Hub since it was last read by Terraform, " +
+			"instead of overlaying the changes of the configuration over it. Can be set via UPDATE_CONFLICT_CHECK environment variable.\n" +
+			"Default: false",
+
+		"adopt_existing": "If true, creating an asset that already exists on the DSF Hub with the same asset_id, e.g. created in the DSF UI or by a discovery job, " +
+			"adopts it instead of failing: the asset is updated to match the configuration and managed by Terraform from then on. " +
+			"Can also be set on each resource. Can be set via ADOPT_EXISTING environment variable.\n" +
+			"Default: false",
+
+		"adopt_managed_by": "The value written to managed_by of the assets adopted with adopt_existing that do not configure managed_by, " +
+			"to mark them as managed by Terraform. Can be set via ADOPT_MANAGED_BY environment variable.",
+
+		"workspace_id": "Identifier of the workspace, written to the ownership_field of the assets it creates as an ownership marker. " +
+			"When set, assets whose ownership marker is not the workspace_id are not updated or deleted unless override_ownership is set on the resource. " +
+			"Can be set via DSFHUB_WORKSPACE_ID environment variable.",
+
+		"ownership_field": "The assetData field the ownership marker is written to, managed_by or any other string field of the asset such as a custom tag. " +
+			"Can be set via OWNERSHIP_FIELD environment variable.\n" +
+			"Default: managed_by",
 	}
 }
 
@@ -38,6 +77,15 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		Params: map[string]string{
 			"syncType": d.Get("sync_type").(string),
 		},
+		StrictAudit:            d.Get("strict_audit").(bool),
+		UnusedConnectionFields: d.Get("unused_connection_fields").(string),
+		AssetDefinitionsSource: d.Get("asset_definitions_source").(string),
+		AssetDefinitionsFile:   d.Get("asset_definitions_file").(string),
+		UpdateConflictCheck:    d.Get("update_conflict_check").(bool),
+		AdoptExisting:          d.Get("adopt_existing").(bool),
+		AdoptManagedBy:         d.Get("adopt_managed_by").(string),
+		WorkspaceID:            d.Get("workspace_id").(string),
+		OwnershipField:         d.Get("ownership_field").(string),
 	}
 
 	return config.Client()
@@ -71,6 +119,61 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("SYNC_TYPE", "SYNC_GW_BLOCKING"),
 				Description: descriptions["sync_type"],
 			},
+			"strict_audit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("STRICT_AUDIT", false),
+				Description: descriptions["strict_audit"],
+			},
+			"unused_connection_fields": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("UNUSED_CONNECTION_FIELDS", "warn"),
+				Description: descriptions["unused_connection_fields"],
+			},
+			"asset_definitions_source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("ASSET_DEFINITIONS_SOURCE", "embedded"),
+				Description:      descriptions["asset_definitions_source"],
+				ValidateDiagFunc: validateAssetDefinitionsSource,
+			},
+			"asset_definitions_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ASSET_DEFINITIONS_FILE", ""),
+				Description: descriptions["asset_definitions_file"],
+			},
+			"update_conflict_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("UPDATE_CONFLICT_CHECK", false),
+				Description: descriptions["update_conflict_check"],
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ADOPT_EXISTING", false),
+				Description: descriptions["adopt_existing"],
+			},
+			"adopt_managed_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ADOPT_MANAGED_BY", ""),
+				Description: descriptions["adopt_managed_by"],
+			},
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DSFHUB_WORKSPACE_ID", ""),
+				Description: descriptions["workspace_id"],
+			},
+			"ownership_field": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OWNERSHIP_FIELD", defaultOwnershipField),
+				Description: descriptions["ownership_field"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -85,13 +188,31 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"dsfhub_cloud_account":  resourceCloudAccount(),
-			"dsfhub_data_source":    resourceDSFDataSource(),
-			"dsfhub_log_aggregator": resourceLogAggregator(),
-			"dsfhub_secret_manager": resourceSecretManager(),
+			"dsfhub_asset_operation":  resourceAssetOperation(),
+			"dsfhub_audit_collection": resourceAuditCollection(),
+			"dsfhub_cloud_account":    resourceCloudAccount(),
+			"dsfhub_data_source":      resourceDSFDataSource(),
+			"dsfhub_log_aggregator":   resourceLogAggregator(),
+			"dsfhub_secret_manager":   resourceSecretManager(),
 		},
 	}
 
+	// the configuration of the assets is validated against the asset
+	// definitions and settings of the provider, once it is configured
+	ignoreParamsByServerType := map[string]map[string]map[string]bool{
+		dsfCloudAccountResourceType:  ignoreCloudAccountParamsByServerType,
+		dsfDataSourceResourceType:    ignoreDataSourceParamsByServerType,
+		dsfLogAggregatorResourceType: ignoreLogAggregatorParamsByServerType,
+		dsfSecretManagerResourceType: ignoreSecretManagerParamsByServerType,
+	}
+	for _, resourceType := range assetReferenceResourceTypes {
+		resource := provider.ResourcesMap[resourceType]
+		resource.ValidateRawResourceConfigFuncs = append(resource.ValidateRawResourceConfigFuncs,
+			requiredFieldsValidator(resourceType, ignoreParamsByServerType[resourceType], resource.Schema, provider.Meta),
+			unusedConnectionFieldsValidator(resourceType, resource.Schema, provider.Meta),
+		)
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -0,0 +1,73 @@
+package dsfhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// adoptExistingEnabled returns true if adopt_existing is enabled on either the
+// provider or the resource
+func adoptExistingEnabled(d *schema.ResourceData, m interface{}) bool {
+	client := m.(*Client)
+	adoptExisting, _ := d.Get("adopt_existing").(bool)
+	return client.config.AdoptExisting || adoptExisting
+}
+
+// adoptExistingAsset is called when creating the asset payload failed with
+// createErr. When adopt_existing is enabled and the hub responded that an
+// asset with the same asset_id already exists, e.g. created in the DSF UI or by
+// a discovery job, it is updated to match the configuration instead and the
+// response of the update is returned. Otherwise createErr is returned, so that
+// other errors such as validation errors never lead to an update.
+func adoptExistingAsset(ctx context.Context, d *schema.ResourceData, m interface{}, resourceType string, resourceSchema map[string]*schema.Schema, payload ResourceWrapper, createErr error) (*ResourceWrapper, error) {
+	if !adoptExistingEnabled(d, m) {
+		return nil, createErr
+	}
+	if !isAlreadyExistsError(createErr) {
+		log.Printf("[DEBUG] Not adopting %s asset %s, creating it did not fail because it already exists | err: %s\n", resourceType, payload.Data.AssetData.AssetID, createErr)
+		return nil, createErr
+	}
+	client := m.(*Client)
+	assetId := payload.Data.AssetData.AssetID
+
+	current, err := readAsset(*client, resourceType, assetId)
+	if err != nil {
+		log.Printf("[DEBUG] No existing %s asset %s to adopt | err: %s\n", resourceType, assetId, err)
+		return nil, createErr
+	}
+	if current.Data.ServerType != payload.Data.ServerType {
+		return nil, fmt.Errorf("asset %s already exists with server_type %s and cannot be adopted as %s | err: %s", assetId, current.Data.ServerType, payload.Data.ServerType, createErr)
+	}
+
+	// adoption takes ownership of unmarked assets, not of those of another workspace
+	if assetDataField(current.Data.AssetData, client.config.ownershipField()) != "" {
+		if err := assetOwnershipError(d, m, current.Data); err != nil {
+			return nil, fmt.Errorf("%s | err: %s", err, createErr)
+		}
+	}
+
+	log.Printf("[INFO] Adopting existing %s asset %s\n", resourceType, assetId)
+	// managed_by is already set when it is the ownership_field of the provider
+	if _, found := d.GetOk("managed_by"); !found && client.config.AdoptManagedBy != "" && payload.Data.AssetData.ManagedBy == "" {
+		payload.Data.AssetData.ManagedBy = client.config.AdoptManagedBy
+	}
+	overlayCurrentAsset(d, resourceSchema, current.Data, &payload, client.assetDefinitions())
+
+	// the audit state is left unchanged by the update, the caller connects
+	// the asset to gateway if audit_pull_enabled is set
+	payload.Data.AssetData.AuditPullEnabled = current.Data.AssetData.AuditPullEnabled
+	response, err := updateAsset(*client, resourceType, assetId, payload)
+	if err != nil {
+		return nil, fmt.Errorf("error adopting existing asset %s | err: %s", assetId, err)
+	}
+
+	if _, found := resourceSchema["audit_pull_enabled"]; found && current.Data.AssetData.AuditPullEnabled && !d.Get("audit_pull_enabled").(bool) {
+		if err := disconnectGateway(ctx, m, assetId, resourceType); err != nil {
+			return nil, fmt.Errorf("error disconnecting adopted asset %s from gateway | err: %s", assetId, err)
+		}
+	}
+	return response, nil
+}
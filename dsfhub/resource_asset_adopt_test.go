@@ -0,0 +1,135 @@
+package dsfhub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testAdoptServer returns a hub with the data source testCurrentDataSource,
+// recording the payload of updates in updated
+func testAdoptServer(t *testing.T, updated *ResourceWrapper) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		switch req.Method + " " + req.URL.Path {
+		case http.MethodGet + " " + baseAPIPrefix + endpointDsfDataSource + "/my-mysql-db":
+			rw.Write([]byte(testCurrentDataSource))
+		case http.MethodPut + " " + baseAPIPrefix + endpointDsfDataSource + "/my-mysql-db":
+			json.Unmarshal(body, updated)
+			rw.Write(body)
+		default:
+			rw.WriteHeader(404)
+			rw.Write([]byte(`{"errors":[{"status":404,"title":"Not Found"}]}`))
+		}
+	}))
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+	return server, client
+}
+
+// testAlreadyExistsError returns the error of creating an asset whose
+// asset_id is already used
+func testAlreadyExistsError() error {
+	return newAPIResponseError([]APIError{{Status: http.StatusConflict, Title: "Conflict", Detail: "asset my-mysql-db already exists"}}, []byte(`{"errors":[{"status":409}]}`))
+}
+
+func TestAdoptExistingAsset(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAdoptExistingAsset \n")
+
+	var updated ResourceWrapper
+	server, client := testAdoptServer(t, &updated)
+	defer server.Close()
+	client.config.AdoptManagedBy = "terraform@email.com"
+
+	config := testExtraDataConfig("", "")
+	config["adopt_existing"] = true
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, config)
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+
+	createErr := testAlreadyExistsError()
+	response, err := adoptExistingAsset(context.Background(), d, client, dsfDataSourceResourceType, resourceDSFDataSource().Schema, payload, createErr)
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if response.Data.AssetData.AssetID != "my-mysql-db" {
+		t.Errorf("Should have returned the updated asset. Got: %v", response.Data.AssetData.AssetID)
+	}
+	if updated.Data.AssetData.AdminEmail != testAdminEmail || updated.Data.AssetData.Location != "my-datacenter" {
+		t.Errorf("Should have updated the asset to match the configuration and kept location. Got: %s, %s", updated.Data.AssetData.AdminEmail, updated.Data.AssetData.Location)
+	}
+	if updated.Data.AssetData.ManagedBy != "terraform@email.com" {
+		t.Errorf("Should have stamped managed_by. Got: %s", updated.Data.AssetData.ManagedBy)
+	}
+}
+
+func TestAdoptExistingAssetNotAdopted(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAdoptExistingAssetNotAdopted \n")
+
+	var updated ResourceWrapper
+	server, client := testAdoptServer(t, &updated)
+	defer server.Close()
+	createErr := testAlreadyExistsError()
+
+	testCases := []struct {
+		name          string
+		adoptExisting bool
+		assetId       string
+		serverType    string
+		expected      string
+	}{
+		{"adopt_existing not set", false, "my-mysql-db", "MYSQL", createErr.Error()},
+		{"no existing asset", true, "other-db", "MYSQL", createErr.Error()},
+		{"other server_type", true, "my-mysql-db", "POSTGRESQL", "asset my-mysql-db already exists with server_type MYSQL and cannot be adopted as POSTGRESQL"},
+	}
+	for _, tc := range testCases {
+		client.config.AdoptExisting = tc.adoptExisting
+		d := resourceDSFDataSource().TestResourceData()
+		payload := ResourceWrapper{}
+		payload.Data.ServerType = tc.serverType
+		payload.Data.AssetData.AssetID = tc.assetId
+		_, err := adoptExistingAsset(context.Background(), d, client, dsfDataSourceResourceType, resourceDSFDataSource().Schema, payload, createErr)
+		if err == nil || !strings.HasPrefix(err.Error(), tc.expected) {
+			t.Errorf("%s: should have returned %q. Got: %v", tc.name, tc.expected, err)
+		}
+	}
+	if updated.Data.AssetData.AssetID != "" {
+		t.Errorf("Should not have updated an asset. Got: %v", updated.Data.AssetData.AssetID)
+	}
+}
+
+func TestAdoptExistingAssetOtherCreateErrors(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAdoptExistingAssetOtherCreateErrors \n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		t.Errorf("Should not have attempted adoption, called %s %s", req.Method, req.URL.Path)
+		rw.WriteHeader(500)
+	}))
+	defer server.Close()
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL, AdoptExisting: true}, httpClient: &http.Client{}}
+
+	createErrs := []error{
+		newAPIResponseError([]APIError{{Status: http.StatusBadRequest, Title: "Bad Request", Detail: "invalid value for server_port"}}, []byte(`{"errors":[{"status":400}]}`)),
+		newAPIResponseError([]APIError{{Status: http.StatusInternalServerError, Title: "Internal Server Error"}}, []byte(`{"errors":[{"status":500}]}`)),
+		errors.New("error adding DSF data source | err: connection refused"),
+	}
+	for _, createErr := range createErrs {
+		d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, testExtraDataConfig("", ""))
+		payload := ResourceWrapper{}
+		createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+		_, err := adoptExistingAsset(context.Background(), d, client, dsfDataSourceResourceType, resourceDSFDataSource().Schema, payload, createErr)
+		if err != createErr {
+			t.Errorf("Should have returned the create error unchanged. Got: %v", err)
+		}
+	}
+}
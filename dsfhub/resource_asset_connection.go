@@ -0,0 +1,108 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// asset_connection blocks are keyed by their reason: an asset has at most one
+// connection per reason, and the blocks are kept in a stable order so that a
+// change to a connection shows up as a change to its fields in the plan.
+
+// uniqueConnectionReasonsCustomizeDiff fails the plan when two asset_connection
+// blocks have the same reason
+func uniqueConnectionReasonsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	connections, ok := d.Get("asset_connection").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	seen := map[string]int{}
+	for i, conn := range connections {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		reason, _ := connection["reason"].(string)
+		if reason == "" || reason == unknownVariableValue {
+			continue
+		}
+		if j, found := seen[reason]; found {
+			return cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr("reason").NewErrorf("duplicate asset_connection reason %q, already used by asset_connection %d, each reason may only be used once", reason, j)
+		}
+		seen[reason] = i
+	}
+	return nil
+}
+
+// connectionReason returns the reason of an asset_connection block
+func connectionReason(conn interface{}) string {
+	if connection, ok := conn.(map[string]interface{}); ok {
+		if reason, ok := connection["reason"].(string); ok {
+			return reason
+		}
+	}
+	return ""
+}
+
+// priorAssetConnections returns the asset_connection blocks in the state by
+// reason
+func priorAssetConnections(d *schema.ResourceData) map[string]map[string]interface{} {
+	priorConnections := map[string]map[string]interface{}{}
+	if prior, ok := d.Get("asset_connection").([]interface{}); ok {
+		for _, conn := range prior {
+			if connection, ok := conn.(map[string]interface{}); ok {
+				priorConnections[connectionReason(connection)] = connection
+			}
+		}
+	}
+	return priorConnections
+}
+
+// reconcileAssetConnections prepares the asset_connection blocks read from the
+// hub to be set in the state: credentials masked by the hub, the versions of
+// write-only credentials and extra_connection_data are taken from the state,
+// and the blocks are ordered like those in the state
+func reconcileAssetConnections(d *schema.ResourceData, connections []interface{}) []interface{} {
+	connections = preserveMaskedSecrets(d, connections)
+	connections = preserveWriteOnlyCredentialVersions(d, connections)
+	connections = preserveExtraConnectionData(d, connections)
+	return orderAssetConnections(d, connections)
+}
+
+// orderAssetConnections orders the asset_connection blocks read from the hub
+// like the blocks already in the state, so that reading an asset does not
+// reorder its connections. Connections with a reason that is not in the state
+// are appended, ordered by reason.
+func orderAssetConnections(d *schema.ResourceData, connections []interface{}) []interface{} {
+	position := map[string]int{}
+	if prior, ok := d.Get("asset_connection").([]interface{}); ok {
+		for i, conn := range prior {
+			if _, found := position[connectionReason(conn)]; !found {
+				position[connectionReason(conn)] = i
+			}
+		}
+	}
+
+	ordered := make([]interface{}, len(connections))
+	copy(ordered, connections)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		reasonI, reasonJ := connectionReason(ordered[i]), connectionReason(ordered[j])
+		positionI, foundI := position[reasonI]
+		positionJ, foundJ := position[reasonJ]
+		switch {
+		case foundI && foundJ:
+			return positionI < positionJ
+		case foundI != foundJ:
+			return foundI
+		default:
+			return reasonI < reasonJ
+		}
+	})
+	log.Printf("[DEBUG] Ordered %d asset_connection blocks by reason\n", len(ordered))
+	return ordered
+}
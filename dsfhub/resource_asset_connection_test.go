@@ -0,0 +1,68 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUniqueConnectionReasonsCustomizeDiff(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestUniqueConnectionReasonsCustomizeDiff \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "mydbhost",
+		"server_ip":          "10.0.0.1",
+		"server_port":        "3306",
+		"server_type":        "MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{"auth_mechanism": "password", "password": "my-password", "reason": "default", "username": "admin"},
+			map[string]interface{}{"auth_mechanism": "password", "password": "my-password", "reason": "default", "username": "other"},
+		},
+	})
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.Contains(err.Error(), "duplicate asset_connection reason \"default\"") {
+		t.Errorf("Should have received a duplicate reason error, got: %s", err)
+	}
+}
+
+func TestOrderAssetConnections(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestOrderAssetConnections \n")
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"asset_connection": []interface{}{
+			map[string]interface{}{"reason": "sonargateway"},
+			map[string]interface{}{"reason": "default"},
+		},
+	})
+
+	connections := []interface{}{
+		map[string]interface{}{"reason": "default"},
+		map[string]interface{}{"reason": "audit management"},
+		map[string]interface{}{"reason": "SDM"},
+		map[string]interface{}{"reason": "sonargateway"},
+	}
+
+	var reasons []string
+	for _, connection := range orderAssetConnections(d, connections) {
+		reasons = append(reasons, connectionReason(connection))
+	}
+	expectedReasons := []string{"sonargateway", "default", "SDM", "audit management"}
+	if !reflect.DeepEqual(reasons, expectedReasons) {
+		t.Errorf("Should have ordered the connections %v. Got: %v", expectedReasons, reasons)
+	}
+}
@@ -0,0 +1,159 @@
+package dsfhub
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// parentResourceTypes are the resource types other assets reference, which are
+// not deleted while assets depend on them unless force_destroy is set
+var parentResourceTypes = []string{
+	dsfCloudAccountResourceType,
+	dsfLogAggregatorResourceType,
+	dsfSecretManagerResourceType,
+}
+
+// checkAssetDeletion returns an error if the asset must not be deleted: when
+// deletion_protection is set, when it is owned by another workspace, or when
+// other assets depend on it and force_destroy is not set
+func checkAssetDeletion(d *schema.ResourceData, m interface{}, resourceType string, assetId string) error {
+	if protected, _ := d.Get("deletion_protection").(bool); protected {
+		return fmt.Errorf("asset %s has deletion_protection set, set it to false and apply before destroying it", assetId)
+	}
+
+	if err := checkAssetOwnership(d, m, resourceType, assetId); err != nil {
+		return err
+	}
+
+	if !contains(parentResourceTypes, resourceType) {
+		return nil
+	}
+	if forceDestroy, _ := d.Get("force_destroy").(bool); forceDestroy {
+		log.Printf("[WARN] Not looking for assets depending on %s, force_destroy is set\n", assetId)
+		return nil
+	}
+	client := m.(*Client)
+	dependents, err := assetDependents(*client, assetId)
+	if err != nil {
+		return fmt.Errorf("error looking for assets depending on %s before deleting it | err: %s", assetId, err)
+	}
+	if len(dependents) > 0 {
+		return fmt.Errorf("asset %s cannot be deleted, other assets depend on it: %s. Delete them or point them at another asset first, or set force_destroy to delete it anyway", assetId, strings.Join(dependents, ", "))
+	}
+	return nil
+}
+
+// dependentAsset is an asset that references another asset
+type dependentAsset struct {
+	ResourceType string
+	Asset        ResourceData
+	// Fields are the fields of Asset referencing the other asset
+	Fields []string
+}
+
+// dependentAssets returns the assets that reference assetId, as returned by
+// the list endpoints of the hub
+func dependentAssets(client Client, assetId string) ([]dependentAsset, error) {
+	readAllFuncs := map[string]func() (*ResourcesWrapper, error){
+		dsfCloudAccountResourceType:  client.ReadCloudAccounts,
+		dsfDataSourceResourceType:    client.ReadDSFDataSources,
+		dsfLogAggregatorResourceType: client.ReadLogAggregators,
+		dsfSecretManagerResourceType: client.ReadSecretManagers,
+	}
+
+	var dependents []dependentAsset
+	for _, resourceType := range assetReferenceResourceTypes {
+		assets, err := readAllFuncs[resourceType]()
+		if err != nil {
+			return nil, err
+		}
+		for _, asset := range assets.Data {
+			if asset.AssetData.AssetID == assetId {
+				continue
+			}
+			if fields := assetReferenceFields(asset, assetId); len(fields) > 0 {
+				dependents = append(dependents, dependentAsset{resourceType, asset, fields})
+			}
+		}
+	}
+	return dependents, nil
+}
+
+// assetDependents returns the asset_ids of the assets that reference assetId,
+// each followed by the field referencing it
+func assetDependents(client Client, assetId string) ([]string, error) {
+	dependents, err := dependentAssets(client, assetId)
+	if err != nil {
+		return nil, err
+	}
+	var descriptions []string
+	for _, dependent := range dependents {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", dependent.Asset.AssetData.AssetID, strings.Join(dependent.Fields, ", ")))
+	}
+	return descriptions, nil
+}
+
+// assetReferenceValue is a field of an asset that may hold the asset_id of
+// another asset
+type assetReferenceValue struct {
+	Field string
+	Value *string
+}
+
+// assetReferenceValues returns the fields of asset that may reference another
+// asset. The secrets of its connections are shared with the asset they were
+// copied from, which is changed as well when a secret_asset_id is set.
+func assetReferenceValues(asset *ResourceData) []assetReferenceValue {
+	values := []assetReferenceValue{
+		{"parent_asset_id", &asset.ParentAssetID},
+		{"parent_asset_id", &asset.AssetData.ParentAssetID},
+		{"logs_destination_asset_id", &asset.AssetData.LogsDestinationAssetID},
+		{"application", &asset.AssetData.Application},
+	}
+	for i := range asset.AssetData.Connections {
+		connectionData := &asset.AssetData.Connections[i].ConnectionData
+		values = append(values, assetReferenceValue{"asset_connection.aws_connection_id", &connectionData.AwsConnectionID})
+		secrets := map[string]*Secret{
+			"amazon_secret":    connectionData.AmazonSecret,
+			"cyberark_secret":  connectionData.CyberarkSecret,
+			"hashicorp_secret": connectionData.HashicorpSecret,
+		}
+		for _, block := range []string{"amazon_secret", "cyberark_secret", "hashicorp_secret"} {
+			if secret := secrets[block]; secret != nil {
+				values = append(values, assetReferenceValue{fmt.Sprintf("asset_connection.%s.secret_asset_id", block), &secret.SecretAssetID})
+			}
+		}
+	}
+	return values
+}
+
+// assetReferenceFields returns the fields of asset that reference assetId
+func assetReferenceFields(asset ResourceData, assetId string) []string {
+	var fields []string
+	for _, reference := range assetReferenceValues(&asset) {
+		if *reference.Value == assetId && !contains(fields, reference.Field) {
+			fields = append(fields, reference.Field)
+		}
+	}
+	return fields
+}
+
+// deleteAssetError returns the error of deleting an asset, or nil if the
+// asset no longer exists on the hub and has already been deleted
+func deleteAssetError(client Client, resourceType string, assetId string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if isNotFoundError(err) {
+		log.Printf("[INFO] %s asset %s has already been deleted | err: %s\n", resourceType, assetId, err)
+		return nil
+	}
+	if _, readErr := readAsset(client, resourceType, assetId); isNotFoundError(readErr) {
+		log.Printf("[INFO] %s asset %s no longer exists after deleting it | err: %s\n", resourceType, assetId, err)
+		return nil
+	}
+	return fmt.Errorf("error deleting %s asset %s | err: %s", resourceType, assetId, err)
+}
@@ -0,0 +1,125 @@
+package dsfhub
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testDependentsServer returns a hub with data sources referencing the cloud
+// account my-aws-account and the secret manager my-secret-manager
+func testDependentsServer(t *testing.T) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Errorf("Should not have called %s %s", req.Method, req.URL.Path)
+		}
+		switch req.URL.Path {
+		case baseAPIPrefix + endpointDsfDataSource:
+			rw.Write([]byte(`{"data": [
+				{"id": "my-rds-db", "serverType": "AWS RDS MYSQL", "parentAssetId": "my-aws-account", "assetData": {"asset_id": "my-rds-db"}},
+				{"id": "my-mysql-db", "serverType": "MYSQL", "assetData": {"asset_id": "my-mysql-db", "connections": [
+					{"reason": "default", "connectionData": {"auth_mechanism": "password", "amazon_secret": {"secret_asset_id": "my-secret-manager", "secret_name": "my-secret"}}}
+				]}}
+			]}`))
+		case baseAPIPrefix + endpointCloudAccounts:
+			rw.Write([]byte(`{"data": [{"id": "my-aws-account", "serverType": "AWS", "assetData": {"asset_id": "my-aws-account"}}]}`))
+		default:
+			rw.Write([]byte(`{"data": []}`))
+		}
+	}))
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+	return server, client
+}
+
+func TestCheckAssetDeletionDependents(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCheckAssetDeletionDependents \n")
+
+	server, client := testDependentsServer(t)
+	defer server.Close()
+
+	testCases := []struct {
+		resource     func() *schema.Resource
+		resourceType string
+		assetId      string
+		forceDestroy bool
+		expected     string
+	}{
+		{resourceCloudAccount, dsfCloudAccountResourceType, "my-aws-account", false, "asset my-aws-account cannot be deleted, other assets depend on it: my-rds-db (parent_asset_id)."},
+		{resourceSecretManager, dsfSecretManagerResourceType, "my-secret-manager", false, "asset my-secret-manager cannot be deleted, other assets depend on it: my-mysql-db (asset_connection.amazon_secret.secret_asset_id)."},
+		{resourceCloudAccount, dsfCloudAccountResourceType, "my-aws-account", true, ""},
+		{resourceLogAggregator, dsfLogAggregatorResourceType, "my-log-aggregator", false, ""},
+	}
+	for _, tc := range testCases {
+		d := schema.TestResourceDataRaw(t, tc.resource().Schema, map[string]interface{}{"force_destroy": tc.forceDestroy})
+		err := checkAssetDeletion(d, client, tc.resourceType, tc.assetId)
+		switch {
+		case tc.expected == "" && err != nil:
+			t.Errorf("Deleting %s should not have returned an error. Got: %s", tc.assetId, err)
+		case tc.expected != "" && (err == nil || !strings.HasPrefix(err.Error(), tc.expected)):
+			t.Errorf("Deleting %s should have returned %q. Got: %v", tc.assetId, tc.expected, err)
+		}
+	}
+}
+
+func TestCheckAssetDeletionProtection(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCheckAssetDeletionProtection \n")
+
+	// no request is expected for data sources, which are not parents
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: "http://127.0.0.1:0"}, httpClient: &http.Client{}}
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{"deletion_protection": true})
+	expected := "asset my-mysql-db has deletion_protection set"
+	if err := checkAssetDeletion(d, client, dsfDataSourceResourceType, "my-mysql-db"); err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Should have returned %q. Got: %v", expected, err)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{"deletion_protection": false})
+	if err := checkAssetDeletion(d, client, dsfDataSourceResourceType, "my-mysql-db"); err != nil {
+		t.Errorf("Should not have returned an error. Got: %s", err)
+	}
+}
+
+func TestDeleteAssetError(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestDeleteAssetError \n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == baseAPIPrefix+endpointDsfDataSource+"/my-mysql-db" {
+			rw.Write([]byte(testCurrentDataSource))
+			return
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":404,"title":"Not Found","detail":"Asset not found"}]}`))
+	}))
+	defer server.Close()
+	client := Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+
+	notFound := newAPIResponseError([]APIError{{Status: 404, Title: "Not Found"}}, []byte(`{"errors": [{"status": 404, "title": "Not Found"}]}`))
+	conflict := newAPIResponseError([]APIError{{Status: 409, Title: "Conflict"}}, []byte(`{"errors":[{"status":409,"title":"Conflict"}]}`))
+	testCases := []struct {
+		name     string
+		assetId  string
+		err      error
+		expected string
+	}{
+		{"deleted", "my-mysql-db", nil, ""},
+		{"already deleted", "my-mysql-db", notFound, ""},
+		{"deleted despite the error", "other-db", conflict, ""},
+		{"not deleted", "my-mysql-db", conflict, "error deleting dsfhub_data_source asset my-mysql-db | err: errors found in json response"},
+	}
+	for _, tc := range testCases {
+		err := deleteAssetError(client, dsfDataSourceResourceType, tc.assetId, tc.err)
+		switch {
+		case tc.expected == "" && err != nil:
+			t.Errorf("%s: should not have returned an error. Got: %s", tc.name, err)
+		case tc.expected != "" && (err == nil || !strings.HasPrefix(err.Error(), tc.expected)):
+			t.Errorf("%s: should have returned %q. Got: %v", tc.name, tc.expected, err)
+		}
+	}
+}
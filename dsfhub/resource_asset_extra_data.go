@@ -0,0 +1,146 @@
+package dsfhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// extra_asset_data and extra_connection_data pass fields that the provider
+// does not model yet through to the hub as JSON objects, merged into assetData
+// and the connectionData of an asset_connection. The fields returned by the
+// hub that the provider does not model are exported as unmodelled_asset_data
+// and unmodelled_connection_data.
+
+// validateJsonObject validates that a string attribute is a JSON object
+func validateJsonObject(i interface{}, path cty.Path) diag.Diagnostics {
+	value, ok := i.(string)
+	if !ok || value == "" {
+		return nil
+	}
+	if _, err := decodeJsonObject([]byte(value)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid JSON object",
+			Detail:        fmt.Sprintf("expected a JSON object, e.g. jsonencode({ field = \"value\" }): %s", err),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+// extraDataValue returns the JSON object of an extra_asset_data or
+// extra_connection_data value, or nil if it is empty or invalid, which
+// validateJsonObject reports
+func extraDataValue(value interface{}) map[string]interface{} {
+	s, ok := value.(string)
+	if !ok || s == "" || s == unknownVariableValue {
+		return nil
+	}
+	extra, err := decodeJsonObject([]byte(s))
+	if err != nil {
+		log.Printf("[WARN] Ignoring invalid extra data %q: %s\n", s, err)
+		return nil
+	}
+	return extra
+}
+
+// unmodelledDataJson returns the JSON of the fields read from the hub that the
+// provider does not model, or an empty string if there are none
+func unmodelledDataJson(extra map[string]interface{}) string {
+	if len(extra) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(extra)
+	if err != nil {
+		log.Printf("[WARN] Marshalling unmodelled data: %s\n", err)
+		return ""
+	}
+	return string(data)
+}
+
+// extraDataConflicts returns the keys of extra that are modelled by a field
+// of structType. Objects set for a field that is a struct are checked key by
+// key, since they are merged with the fields of the struct.
+func extraDataConflicts(structType reflect.Type, extra map[string]interface{}, path string) []string {
+	fields := jsonFields(structType)
+	var conflicts []string
+	for key, value := range extra {
+		fieldType, found := fields[key]
+		if !found {
+			continue
+		}
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if object, ok := value.(map[string]interface{}); ok && fieldType.Kind() == reflect.Struct {
+			conflicts = append(conflicts, extraDataConflicts(fieldType, object, path+key+".")...)
+			continue
+		}
+		conflicts = append(conflicts, path+key)
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+// extraDataConflictError returns the error for the keys of attribute that
+// are modelled by the provider
+func extraDataConflictError(path cty.Path, attribute string, conflicts []string) error {
+	return path.NewErrorf("%s sets \"%s\", which the provider manages: set them with their attribute instead", attribute, strings.Join(conflicts, "\", \""))
+}
+
+// extraDataCustomizeDiff fails the plan when extra_asset_data or the
+// extra_connection_data of an asset_connection sets a field that the provider
+// models
+func extraDataCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	extra := extraDataValue(d.Get("extra_asset_data"))
+	if conflicts := extraDataConflicts(reflect.TypeOf(AssetData{}), extra, ""); len(conflicts) > 0 {
+		return extraDataConflictError(cty.GetAttrPath("extra_asset_data"), "extra_asset_data", conflicts)
+	}
+
+	connections, ok := d.Get("asset_connection").([]interface{})
+	if !ok {
+		return nil
+	}
+	for i, conn := range connections {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		extra := extraDataValue(connection["extra_connection_data"])
+		if conflicts := extraDataConflicts(reflect.TypeOf(ConnectionData{}), extra, ""); len(conflicts) > 0 {
+			return extraDataConflictError(cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr("extra_connection_data"), "extra_connection_data", conflicts)
+		}
+	}
+	return nil
+}
+
+// preserveExtraConnectionData takes the extra_connection_data of each
+// asset_connection read from the hub from the state, since the hub returns
+// its fields merged into connectionData
+func preserveExtraConnectionData(d *schema.ResourceData, connections []interface{}) []interface{} {
+	priorConnections := priorAssetConnections(d)
+
+	for _, conn := range connections {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		priorConnection, found := priorConnections[connectionReason(connection)]
+		if !found {
+			continue
+		}
+		if extra, ok := priorConnection["extra_connection_data"]; ok {
+			connection["extra_connection_data"] = extra
+		}
+	}
+	return connections
+}
@@ -0,0 +1,156 @@
+package dsfhub
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testExtraDataConfig returns the config of a MYSQL data source with the
+// extra data
+func testExtraDataConfig(extraAssetData string, extraConnectionData string) map[string]interface{} {
+	return map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"extra_asset_data":   extraAssetData,
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "mydbhost",
+		"server_ip":          "10.0.0.1",
+		"server_port":        "3306",
+		"server_type":        "MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism":        "password",
+				"extra_connection_data": extraConnectionData,
+				"password":              "my-password",
+				"reason":                "default",
+				"username":              "admin",
+			},
+		},
+	}
+}
+
+func TestExtraDataMergedIntoPayload(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestExtraDataMergedIntoPayload \n")
+
+	config := testExtraDataConfig(`{"new_field": "new", "aws_proxy_config": {"no_proxy": "localhost"}, "limit": 12345678901}`, `{"new_option": true}`)
+	config["aws_proxy_config"] = []interface{}{map[string]interface{}{"http": "http://proxy"}}
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, config)
+	asset := ResourceWrapper{}
+	createResource(&asset, "MYSQL", d, embeddedAssetDefinitions)
+
+	payload, err := json.Marshal(asset)
+	if err != nil {
+		t.Fatalf("Should have marshalled the payload: %s", err)
+	}
+	var sent struct {
+		Data struct {
+			AssetData map[string]interface{} `json:"assetData"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &sent); err != nil {
+		t.Fatal(err)
+	}
+	assetData := sent.Data.AssetData
+	if assetData["new_field"] != "new" || assetData["admin_email"] != testAdminEmail {
+		t.Errorf("Should have merged new_field into assetData. Got: %v", assetData)
+	}
+	if !strings.Contains(string(payload), `"limit":12345678901`) {
+		t.Errorf("Should have sent limit unchanged. Got: %s", payload)
+	}
+	proxy := assetData["aws_proxy_config"].(map[string]interface{})
+	if proxy["http"] != "http://proxy" || proxy["no_proxy"] != "localhost" {
+		t.Errorf("Should have merged aws_proxy_config. Got: %v", proxy)
+	}
+	connectionData := assetData["connections"].([]interface{})[0].(map[string]interface{})["connectionData"].(map[string]interface{})
+	if connectionData["new_option"] != true || connectionData["username"] != "admin" {
+		t.Errorf("Should have merged new_option into connectionData. Got: %v", connectionData)
+	}
+}
+
+func TestUnmodelledDataReadFromHub(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestUnmodelledDataReadFromHub \n")
+
+	response := `{"data": {"id": "my-mysql-db", "serverType": "MYSQL", "assetData": {
+		"asset_id": "my-mysql-db",
+		"hub_field": {"nested": 1},
+		"connections": [{"reason": "default", "connectionData": {"username": "admin", "hub_option": "x"}}]
+	}}}`
+	var asset ResourceWrapper
+	if err := json.Unmarshal([]byte(response), &asset); err != nil {
+		t.Fatalf("Should have unmarshalled the response: %s", err)
+	}
+	if !reflect.DeepEqual(asset.Data.AssetData.Extra, map[string]interface{}{"hub_field": map[string]interface{}{"nested": json.Number("1")}}) {
+		t.Errorf("Should have kept hub_field in Extra. Got: %v", asset.Data.AssetData.Extra)
+	}
+
+	prior := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, testExtraDataConfig("", `{"hub_option": "x"}`))
+	if diags := flattenAsset(prior, resourceDSFDataSource().Schema, asset.Data, embeddedAssetDefinitions); diags.HasError() {
+		t.Fatalf("Should not have received an error: %v", diags)
+	}
+	expected := map[string]interface{}{
+		"unmodelled_asset_data":                         `{"hub_field":{"nested":1}}`,
+		"asset_connection.0.unmodelled_connection_data": `{"hub_option":"x"}`,
+		"asset_connection.0.extra_connection_data":      `{"hub_option": "x"}`,
+		"asset_connection.0.username":                   "admin",
+	}
+	for name, value := range expected {
+		if got := prior.Get(name); got != value {
+			t.Errorf("Should have set %s to %v. Got: %v", name, value, got)
+		}
+	}
+}
+
+func TestExtraDataConflictsWithModelledFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestExtraDataConflictsWithModelledFields \n")
+
+	testCases := []struct {
+		extraAssetData      string
+		extraConnectionData string
+		expected            string
+	}{
+		{`{"admin_email": "other@email.com"}`, "", `extra_asset_data sets "admin_email"`},
+		{`{"aws_proxy_config": {"http": "http://proxy"}}`, "", `extra_asset_data sets "aws_proxy_config.http"`},
+		{"", `{"username": "other", "new_option": true}`, `extra_connection_data sets "username"`},
+		{`{"new_field": "new", "aws_proxy_config": {"no_proxy": "localhost"}}`, `{"new_option": true}`, ""},
+	}
+	for _, tc := range testCases {
+		config := terraform.NewResourceConfigRaw(testExtraDataConfig(tc.extraAssetData, tc.extraConnectionData))
+		_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+		switch {
+		case tc.expected == "" && err != nil:
+			t.Errorf("%s %s should have been accepted. Got: %s", tc.extraAssetData, tc.extraConnectionData, err)
+		case tc.expected != "" && (err == nil || !strings.Contains(err.Error(), tc.expected)):
+			t.Errorf("%s %s should have received %q. Got: %v", tc.extraAssetData, tc.extraConnectionData, tc.expected, err)
+		}
+	}
+}
+
+func TestValidateJsonObject(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestValidateJsonObject \n")
+
+	for value, valid := range map[string]bool{
+		"":                  true,
+		`{}`:                true,
+		`{"field": [1, 2]}`: true,
+		`[]`:                false,
+		`"field"`:           false,
+		`{"field": }`:       false,
+	} {
+		if diags := validateJsonObject(value, cty.GetAttrPath("extra_asset_data")); diags.HasError() == valid {
+			t.Errorf("%q should have been valid: %t. Got: %v", value, valid, diags)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package dsfhub
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// assetAttributesKeptWhenEmpty lists the attributes that keep their value in
+// the state when the hub returns them empty, as it does for some server types
+var assetAttributesKeptWhenEmpty = []string{
+	"arn",
+	"credentials_endpoint",
+	"server_ip",
+}
+
+// flattenAsset sets the attributes of d from asset, as read from the hub. It
+// is the inverse of createResource: the fields of ResourceData, AssetData and
+// ConnectionData are mapped to the attributes with their id in assetSchemaJson.
+// Only the attributes of resourceSchema are set, and an error is returned for
+// each attribute that could not be set.
+func flattenAsset(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, asset ResourceData, definitions *assetDefinitions) diag.Diagnostics {
+	assetSchema := getSchema(definitions)
+
+	values := map[string]interface{}{}
+	flattenAssetFields(reflect.ValueOf(asset), assetSchema.Details, resourceSchema, values)
+	flattenAssetFields(reflect.ValueOf(asset.AssetData), assetSchema.Details, resourceSchema, values)
+	if _, found := resourceSchema["unmodelled_asset_data"]; found {
+		values["unmodelled_asset_data"] = unmodelledDataJson(asset.AssetData.Extra)
+	}
+	if _, found := resourceSchema["asset_checksum"]; found {
+		values["asset_checksum"] = assetChecksum(asset)
+	}
+
+	if connectionSchema, found := resourceSchema["asset_connection"]; found {
+		connectionElem := connectionSchema.Elem.(*schema.Resource)
+		connections := make([]interface{}, 0, len(asset.AssetData.Connections))
+		for _, assetConnection := range asset.AssetData.Connections {
+			connection := map[string]interface{}{"reason": assetConnection.Reason}
+			flattenAssetFields(reflect.ValueOf(assetConnection.ConnectionData), assetSchema.Connections, connectionElem.Schema, connection)
+			if _, found := connectionElem.Schema["unmodelled_connection_data"]; found {
+				connection["unmodelled_connection_data"] = unmodelledDataJson(assetConnection.ConnectionData.Extra)
+			}
+			connections = append(connections, connection)
+		}
+		values["asset_connection"] = reconcileAssetConnections(d, connections)
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diags diag.Diagnostics
+	for _, name := range names {
+		if err := d.Set(name, values[name]); err != nil {
+			log.Printf("[ERROR] Setting %s | err: %s\n", name, err)
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Error setting %s", name),
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath(name),
+			})
+		}
+	}
+	return diags
+}
+
+// flattenAssetFields adds to values the value of each field of the struct v
+// that is listed in fields and has an attribute in resourceSchema
+func flattenAssetFields(v reflect.Value, fields map[string]SchemaField, resourceSchema map[string]*schema.Schema, values map[string]interface{}) {
+	for i := 0; i < v.NumField(); i++ {
+		structField := v.Type().Field(i)
+		schemaField, found := fields[structField.Name]
+		if !found {
+			continue
+		}
+		attributeSchema, found := resourceSchema[schemaField.ID]
+		if !found {
+			continue
+		}
+		value, ok := flattenAssetValue(v.Field(i), attributeSchema)
+		if !ok {
+			continue
+		}
+		if value == "" {
+			// ParentAssetID is both in ResourceData and AssetData, keep the
+			// first one set
+			if _, found := values[schemaField.ID]; found || contains(assetAttributesKeptWhenEmpty, schemaField.ID) {
+				continue
+			}
+		}
+		values[schemaField.ID] = value
+	}
+}
+
+// flattenAssetValue converts the value of a field of the hub payload into the
+// type of the attribute attributeSchema. Nil values are not converted.
+func flattenAssetValue(v reflect.Value, attributeSchema *schema.Schema) (interface{}, bool) {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+
+	switch attributeSchema.Type {
+	case schema.TypeString:
+		switch v.Kind() {
+		case reflect.String:
+			return v.String(), true
+		case reflect.Int, reflect.Int64:
+			return strconv.FormatInt(v.Int(), 10), true
+		case reflect.Float64:
+			// numbers in the payload are decoded as float64, e.g. server_port
+			if f := v.Float(); f == math.Trunc(f) {
+				return strconv.FormatInt(int64(f), 10), true
+			}
+			return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+		case reflect.Bool:
+			return strconv.FormatBool(v.Bool()), true
+		}
+	case schema.TypeInt:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int64:
+			return int(v.Int()), true
+		case reflect.Float64:
+			return int(v.Float()), true
+		case reflect.String:
+			if i, err := strconv.Atoi(strings.TrimSpace(v.String())); err == nil {
+				return i, true
+			}
+		}
+	case schema.TypeFloat:
+		switch v.Kind() {
+		case reflect.Float64:
+			return v.Float(), true
+		case reflect.Int, reflect.Int64:
+			return float64(v.Int()), true
+		}
+	case schema.TypeBool:
+		if v.Kind() == reflect.Bool {
+			return v.Bool(), true
+		}
+	case schema.TypeMap:
+		if v.Kind() == reflect.Map {
+			elemSchema, ok := attributeSchema.Elem.(*schema.Schema)
+			if !ok {
+				elemSchema = &schema.Schema{Type: schema.TypeString}
+			}
+			m := make(map[string]interface{}, v.Len())
+			for _, key := range v.MapKeys() {
+				if value, ok := flattenAssetValue(v.MapIndex(key), elemSchema); ok {
+					m[fmt.Sprintf("%v", key.Interface())] = value
+				}
+			}
+			return m, true
+		}
+	case schema.TypeList, schema.TypeSet:
+		switch elem := attributeSchema.Elem.(type) {
+		case *schema.Schema:
+			if v.Kind() == reflect.Slice {
+				list := make([]interface{}, 0, v.Len())
+				for i := 0; i < v.Len(); i++ {
+					if value, ok := flattenAssetValue(v.Index(i), elem); ok {
+						list = append(list, value)
+					}
+				}
+				return list, true
+			}
+		case *schema.Resource:
+			switch v.Kind() {
+			case reflect.Struct:
+				return []interface{}{flattenAssetStruct(v, elem.Schema)}, true
+			case reflect.Slice:
+				list := make([]interface{}, 0, v.Len())
+				for i := 0; i < v.Len(); i++ {
+					if value, ok := flattenAssetValue(v.Index(i), &schema.Schema{Type: schema.TypeList, Elem: elem}); ok {
+						list = append(list, value.([]interface{})...)
+					}
+				}
+				return list, true
+			}
+		}
+	}
+
+	log.Printf("[WARN] Cannot flatten a %s into an attribute of type %s\n", v.Type(), attributeSchema.Type)
+	return nil, false
+}
+
+// flattenAssetStruct converts a nested struct of the hub payload, e.g. Secret,
+// into a block of elemSchema. Its fields are mapped to the attributes named
+// after their json key.
+func flattenAssetStruct(v reflect.Value, elemSchema map[string]*schema.Schema) map[string]interface{} {
+	block := map[string]interface{}{}
+	for i := 0; i < v.NumField(); i++ {
+		name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+		attributeSchema, found := elemSchema[name]
+		if !found {
+			continue
+		}
+		if value, ok := flattenAssetValue(v.Field(i), attributeSchema); ok {
+			block[name] = value
+		}
+	}
+	return block
+}
@@ -0,0 +1,150 @@
+package dsfhub
+
+import (
+	"log"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenAsset(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestFlattenAsset \n")
+
+	asset := ResourceData{
+		GatewayID:  "my-gateway",
+		ID:         "my-mysql-db",
+		ServerType: "MYSQL",
+	}
+	asset.AssetData.AdminEmail = testAdminEmail
+	asset.AssetData.AssetDisplayName = "my-mysql-db"
+	asset.AssetData.AssetID = "my-mysql-db"
+	asset.AssetData.AuditInfo = &AuditInfo{PolicyTemplateName: "my-policy"}
+	asset.AssetData.Criticality = 2
+	asset.AssetData.Searches = []interface{}{"my-search"}
+	asset.AssetData.ServerPort = float64(3306)
+	asset.AssetData.Version = 8.0
+	asset.AssetData.Connections = []AssetConnection{
+		{
+			Reason: "default",
+			ConnectionData: ConnectionData{
+				AmazonSecret:  &Secret{FieldMapping: map[string]string{"password": "db-password"}, SecretAssetID: "my-secret-manager", SecretName: "my-secret"},
+				AuthMechanism: "kerberos",
+				CaFile:        "/ca.pem",
+				CertFile:      "/cert.pem",
+				KerberosKdc:   "kdc.example.com",
+				Username:      "admin",
+			},
+		},
+	}
+
+	d := resourceDSFDataSource().TestResourceData()
+	if diags := flattenAsset(d, resourceDSFDataSource().Schema, asset, embeddedAssetDefinitions); diags.HasError() {
+		t.Fatalf("Should not have received an error: %v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"admin_email":                       testAdminEmail,
+		"asset_id":                          "my-mysql-db",
+		"asset_version":                     8.0,
+		"criticality":                       2,
+		"gateway_id":                        "my-gateway",
+		"searches":                          []interface{}{"my-search"},
+		"server_port":                       "3306",
+		"server_type":                       "MYSQL",
+		"asset_connection.0.auth_mechanism": "kerberos",
+		"asset_connection.0.ca_file":        "/ca.pem",
+		"asset_connection.0.cert_file":      "/cert.pem",
+		"asset_connection.0.kerberos_kdc":   "kdc.example.com",
+		"asset_connection.0.reason":         "default",
+		"asset_connection.0.username":       "admin",
+	}
+	for name, value := range expected {
+		if got := d.Get(name); !reflect.DeepEqual(got, value) {
+			t.Errorf("Should have set %s to %v. Got: %v", name, value, got)
+		}
+	}
+
+	auditInfo := d.Get("audit_info").(*schema.Set).List()
+	if len(auditInfo) != 1 || auditInfo[0].(map[string]interface{})["policy_template_name"] != "my-policy" {
+		t.Errorf("Should have set audit_info. Got: %v", auditInfo)
+	}
+
+	secrets := d.Get("asset_connection.0.amazon_secret").(*schema.Set).List()
+	if len(secrets) != 1 {
+		t.Fatalf("Should have set amazon_secret. Got: %v", secrets)
+	}
+	secret := secrets[0].(map[string]interface{})
+	if !reflect.DeepEqual(secret["field_mapping"], map[string]interface{}{"password": "db-password"}) || secret["secret_name"] != "my-secret" {
+		t.Errorf("Should have set amazon_secret with its field_mapping. Got: %v", secret)
+	}
+}
+
+func TestFlattenAssetInverseOfCreateResource(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestFlattenAssetInverseOfCreateResource \n")
+
+	config := map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"searches":           []interface{}{"my-search"},
+		"server_host_name":   "mydbhost",
+		"server_port":        "3306",
+		"server_type":        "MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{"auth_mechanism": "password", "password": "my-password", "reason": "default", "username": "admin"},
+		},
+	}
+	created := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, config)
+	asset := ResourceWrapper{}
+	createResource(&asset, "MYSQL", created, embeddedAssetDefinitions)
+
+	// the payload is sent to the hub and returned as json
+	d := resourceDSFDataSource().TestResourceData()
+	if diags := flattenAsset(d, resourceDSFDataSource().Schema, asset.Data, embeddedAssetDefinitions); diags.HasError() {
+		t.Fatalf("Should not have received an error: %v", diags)
+	}
+	names := []string{"asset_connection.#", "asset_connection.0.auth_mechanism", "asset_connection.0.password", "asset_connection.0.reason", "asset_connection.0.username"}
+	for name := range config {
+		if name != "asset_connection" {
+			names = append(names, name)
+		}
+	}
+	for _, name := range names {
+		if !reflect.DeepEqual(d.Get(name), created.Get(name)) {
+			t.Errorf("Should have read back %s as %v. Got: %v", name, created.Get(name), d.Get(name))
+		}
+	}
+}
+
+func TestFlattenAssetSetErrors(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestFlattenAssetSetErrors \n")
+
+	asset := ResourceData{}
+	asset.AssetData.AssetID = "my-mysql-db"
+	asset.AssetData.AssetDisplayName = "my-mysql-db"
+
+	// d does not have the asset_display_name attribute of the schema used to
+	// flatten the asset
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"asset_id": {Type: schema.TypeString, Optional: true}}, nil)
+	diags := flattenAsset(d, resourceDSFDataSource().Schema, asset, embeddedAssetDefinitions)
+	if !diags.HasError() {
+		t.Fatalf("Should have received an error")
+	}
+	found := false
+	for _, diagnostic := range diags {
+		if diagnostic.Summary == "Error setting asset_display_name" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Should have received an error setting asset_display_name. Got: %v", diags)
+	}
+	if d.Get("asset_id") != "my-mysql-db" {
+		t.Errorf("Should have set asset_id. Got: %v", d.Get("asset_id"))
+	}
+}
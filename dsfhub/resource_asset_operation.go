@@ -0,0 +1,133 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// assetOperationAssetTypes maps the asset_type argument of the
+// dsfhub_asset_operation resource to the resource type of the asset
+var assetOperationAssetTypes = map[string]string{
+	"cloud_account":  dsfCloudAccountResourceType,
+	"data_source":    dsfDataSourceResourceType,
+	"log_aggregator": dsfLogAggregatorResourceType,
+	"secret_manager": dsfSecretManagerResourceType,
+}
+
+// assetOperationEndpoints maps a resource type to the collection endpoint its
+// operations are run against
+var assetOperationEndpoints = map[string]string{
+	dsfCloudAccountResourceType:  endpointCloudAccounts,
+	dsfDataSourceResourceType:    endpointDsfDataSource,
+	dsfLogAggregatorResourceType: endpointLogAggregators,
+	dsfSecretManagerResourceType: endpointSecretManagers,
+}
+
+func resourceAssetOperation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAssetOperationCreateContext,
+		ReadContext:   resourceAssetOperationReadContext,
+		DeleteContext: resourceAssetOperationDeleteContext,
+		Description:   "Runs a DSF Hub operation against an existing asset on create, and again whenever the triggers change.",
+
+		Schema: map[string]*schema.Schema{
+			"asset_id": {
+				Type:        schema.TypeString,
+				Description: "The asset_id of the asset to run the operation against.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"asset_type": {
+				Type:         schema.TypeString,
+				Description:  "The kind of asset referenced by asset_id. Available values: \"cloud_account\", \"data_source\", \"log_aggregator\", \"secret_manager\". Default: \"data_source\"",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "data_source",
+				ValidateFunc: validation.StringInSlice([]string{"cloud_account", "data_source", "log_aggregator", "secret_manager"}, false),
+			},
+			"body": {
+				Type:         schema.TypeString,
+				Description:  "The JSON body sent with the operation request.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"operation": {
+				Type:         schema.TypeString,
+				Description:  "The name of the operation to run, e.g. \"sync\" for POST /{collection}/{asset_id}/operations/sync.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"response": {
+				Type:        schema.TypeString,
+				Description: "The data returned by the hub for the last run of the operation.",
+				Computed:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "A map of arbitrary strings that, when changed, causes the operation to be run again.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_sync": {
+				Type:        schema.TypeBool,
+				Description: "If true, wait for the asset's remoteSyncState to be \"SYNCED\" after running the operation. Default: false",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceAssetOperationCreateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	assetId := d.Get("asset_id").(string)
+	operation := d.Get("operation").(string)
+	resourceType := assetOperationAssetTypes[d.Get("asset_type").(string)]
+
+	var body []byte
+	if v, ok := d.GetOk("body"); ok {
+		body = []byte(v.(string))
+	}
+
+	log.Printf("[INFO] Running operation %s for %s asset %s\n", operation, resourceType, assetId)
+	assetOperationResponse, err := client.RunAssetOperation(assetOperationEndpoints[resourceType], assetId, operation, body)
+	if err != nil {
+		log.Printf("[ERROR] Running operation %s for %s asset %s | err: %s\n", operation, resourceType, assetId, err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("response", string(assetOperationResponse.Data))
+
+	if d.Get("wait_for_sync").(bool) {
+		err = waitForRemoteSyncState(ctx, resourceType, assetId, m)
+		if err != nil {
+			return diag.FromErr(remoteSyncStateError(*client, resourceType, assetId, err))
+		}
+	}
+
+	return resourceAssetOperationReadContext(ctx, d, m)
+}
+
+// resourceAssetOperationReadContext is a no-op: like null_resource, an
+// operation has no remote state to refresh once it has run
+func resourceAssetOperationReadContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceAssetOperationDeleteContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing operation %s for asset %s from state\n", d.Get("operation"), d.Get("asset_id"))
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,52 @@
+package dsfhub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDSFAssetOperation_Basic(t *testing.T) {
+	gatewayId := checkGatewayId(t)
+
+	const (
+		assetId                = "arn:aws:rds:us-east-2:123456789012:db:my-asset-operation-db"
+		resourceName           = "my-asset-operation"
+		dataSourceResourceName = "my-asset-operation-db"
+	)
+
+	resourceTypeAndName := fmt.Sprintf("%s.%s", dsfAssetOperationResourceType, resourceName)
+	dataSourceResourceTypeAndName := fmt.Sprintf("%s.%s", dsfDataSourceResourceType, dataSourceResourceName)
+
+	dataSourceConfig := testAccDSFDataSourceConfig_AwsRdsOracle(dataSourceResourceName, gatewayId, assetId, "LOG_GROUP", "false")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Run the operation on create
+			{
+				Config: ConfigCompose(
+					dataSourceConfig,
+					testAccDSFAssetOperationConfig_Basic(resourceName, dataSourceResourceTypeAndName+".asset_id", "data_source", "disable-audit-collection", "1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "operation", "disable-audit-collection"),
+					resource.TestCheckResourceAttrSet(resourceTypeAndName, "response"),
+				),
+			},
+			// Run the operation again when triggers change
+			{
+				Config: ConfigCompose(
+					dataSourceConfig,
+					testAccDSFAssetOperationConfig_Basic(resourceName, dataSourceResourceTypeAndName+".asset_id", "data_source", "disable-audit-collection", "2"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "triggers.run", "2"),
+					resource.TestCheckResourceAttrSet(resourceTypeAndName, "response"),
+				),
+			},
+		},
+	})
+}
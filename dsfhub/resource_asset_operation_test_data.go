@@ -0,0 +1,21 @@
+package dsfhub
+
+import "fmt"
+
+// Output a terraform config for an asset operation resource.
+func testAccDSFAssetOperationConfig_Basic(resourceName string, assetId string, assetType string, operation string, trigger string) string {
+	// handle reference to other assets
+	assetIdVal := testAccParseResourceAttributeReference(assetId)
+
+	return fmt.Sprintf(`
+resource "%[1]s" "%[2]s" {
+  asset_id      = %[3]s
+  asset_type    = "%[4]s"
+  operation     = "%[5]s"
+  wait_for_sync = true
+
+  triggers = {
+    run = "%[6]s"
+  }
+}`, dsfAssetOperationResourceType, resourceName, assetIdVal, assetType, operation, trigger)
+}
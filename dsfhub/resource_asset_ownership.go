@@ -0,0 +1,154 @@
+package dsfhub
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// When workspace_id is set on the provider, the assets created by Terraform
+// are marked with it in the ownership_field of their assetData, managed_by by
+// default. Assets whose marker is another workspace_id, or that have none, are
+// not updated or deleted unless override_ownership is set on the resource, so
+// that a mistaken import or a copied asset_id does not change the assets of
+// another workspace sharing the hub.
+
+// defaultOwnershipField is the assetData field of the ownership marker
+const defaultOwnershipField = "managed_by"
+
+// ownershipField returns the json key in assetData of the ownership marker
+func (c *Config) ownershipField() string {
+	if c.OwnershipField == "" {
+		return defaultOwnershipField
+	}
+	return c.OwnershipField
+}
+
+// applyOwnershipMarker writes the workspace_id of the provider to the
+// ownership field of the asset payload. An error is returned if the
+// configuration sets the ownership field to another value.
+func applyOwnershipMarker(d *schema.ResourceData, m interface{}, payload *ResourceWrapper) error {
+	config := m.(*Client).config
+	if config.WorkspaceID == "" {
+		return nil
+	}
+	field := config.ownershipField()
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(field))
+	if !diags.HasError() && value.IsKnown() && !value.IsNull() && value.Type().Equals(cty.String) && value.AsString() != config.WorkspaceID {
+		return fmt.Errorf("%s is the ownership_field of the provider and must not be set: it is set to the workspace_id %q of the provider", field, config.WorkspaceID)
+	}
+	log.Printf("[DEBUG] Marking asset %s as owned by workspace %s in %s\n", payload.Data.AssetData.AssetID, config.WorkspaceID, field)
+	setAssetDataField(&payload.Data.AssetData, field, config.WorkspaceID)
+	return nil
+}
+
+// assetOwnershipError returns an error if current, the asset as read from the
+// hub, is not marked as owned by the workspace_id of the provider and
+// override_ownership is not set on the resource
+func assetOwnershipError(d *schema.ResourceData, m interface{}, current ResourceData) error {
+	config := m.(*Client).config
+	err := workspaceOwnershipError(config, current)
+	if err == nil {
+		return nil
+	}
+	if override, _ := d.Get("override_ownership").(bool); override {
+		log.Printf("[WARN] Acting on asset %s anyway, override_ownership is set | err: %s\n", current.AssetData.AssetID, err)
+		return nil
+	}
+	if assetDataField(current.AssetData, config.ownershipField()) == "" {
+		return fmt.Errorf("%s, set override_ownership on the resource to take ownership of it", err)
+	}
+	return fmt.Errorf("%s, set override_ownership on the resource to act on it anyway", err)
+}
+
+// workspaceOwnershipError returns an error if current, the asset as read from
+// the hub, is not marked as owned by the workspace_id of the provider. Assets
+// that are not managed by the resource being applied, e.g. the assets
+// referencing a renamed asset, are checked with it directly.
+func workspaceOwnershipError(config *Config, current ResourceData) error {
+	if config.WorkspaceID == "" {
+		return nil
+	}
+	marker := assetDataField(current.AssetData, config.ownershipField())
+	switch marker {
+	case config.WorkspaceID:
+		return nil
+	case "":
+		return fmt.Errorf("asset %s has no ownership marker in %s and is not owned by workspace %s", current.AssetData.AssetID, config.ownershipField(), config.WorkspaceID)
+	}
+	return fmt.Errorf("asset %s is owned by %q according to %s, not by workspace %s", current.AssetData.AssetID, marker, config.ownershipField(), config.WorkspaceID)
+}
+
+// checkAssetOwnership reads an asset and returns assetOwnershipError. An
+// asset that cannot be read is left to the caller.
+func checkAssetOwnership(d *schema.ResourceData, m interface{}, resourceType string, assetId string) error {
+	client := m.(*Client)
+	if client.config.WorkspaceID == "" {
+		return nil
+	}
+	current, err := readAsset(*client, resourceType, assetId)
+	if err != nil {
+		log.Printf("[DEBUG] Not checking the ownership of asset %s, it could not be read | err: %s\n", assetId, err)
+		return nil
+	}
+	return assetOwnershipError(d, m, current.Data)
+}
+
+// assetDataField returns the value of the field of assetData with a json key,
+// which is either a string field of AssetData or a key of its Extra fields,
+// or "" if it is not set
+func assetDataField(assetData AssetData, key string) string {
+	v := reflect.ValueOf(assetData)
+	for i := 0; i < v.NumField(); i++ {
+		if jsonKey(v.Type().Field(i)) != key {
+			continue
+		}
+		if field := v.Field(i); field.Kind() == reflect.String {
+			return field.String()
+		}
+		return ""
+	}
+	value := assetData.Extra[key]
+	if isEmptyAttributeValue(value) {
+		return ""
+	}
+	if marker, ok := value.(string); ok {
+		return marker
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// setAssetDataField sets the string field of assetData with a json key, or
+// adds it to its Extra fields if AssetData has no such field
+func setAssetDataField(assetData *AssetData, key string, value string) {
+	v := reflect.ValueOf(assetData).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if jsonKey(v.Type().Field(i)) != key {
+			continue
+		}
+		if field := v.Field(i); field.Kind() == reflect.String {
+			field.SetString(value)
+		} else {
+			log.Printf("[WARN] Cannot write the ownership marker to %s, a %s field\n", key, field.Type())
+		}
+		return
+	}
+	if assetData.Extra == nil {
+		assetData.Extra = map[string]interface{}{}
+	}
+	assetData.Extra[key] = value
+}
+
+// validateOwnershipField returns an error if the ownership_field of the
+// provider is a field of AssetData that does not hold a string. Fields the
+// provider does not model are written to the extra asset data.
+func validateOwnershipField(field string) error {
+	fieldType, found := jsonFields(reflect.TypeOf(AssetData{}))[field]
+	if found && fieldType.Kind() != reflect.String {
+		return fmt.Errorf("invalid ownership_field %q: the ownership marker can only be written to a string field of the asset, %s is a %s", field, field, fieldType)
+	}
+	return nil
+}
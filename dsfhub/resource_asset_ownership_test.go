@@ -0,0 +1,230 @@
+package dsfhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestApplyOwnershipMarker(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestApplyOwnershipMarker \n")
+
+	client := &Client{config: &Config{WorkspaceID: "team-a/prod"}, httpClient: &http.Client{}}
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, testExtraDataConfig("", ""))
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	if err := applyOwnershipMarker(d, client, &payload); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if payload.Data.AssetData.ManagedBy != "team-a/prod" {
+		t.Errorf("Should have written the workspace_id to managed_by. Got: %s", payload.Data.AssetData.ManagedBy)
+	}
+
+	client.config.OwnershipField = "owner_tag"
+	payload = ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	if err := applyOwnershipMarker(d, client, &payload); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if payload.Data.AssetData.Extra["owner_tag"] != "team-a/prod" || payload.Data.AssetData.ManagedBy != "" {
+		t.Errorf("Should have written the workspace_id to owner_tag only. Got: %v, %s", payload.Data.AssetData.Extra, payload.Data.AssetData.ManagedBy)
+	}
+}
+
+func TestApplyOwnershipMarkerConfigured(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestApplyOwnershipMarkerConfigured \n")
+
+	r := resourceDSFDataSource()
+	rawConfig, err := ctyjson.Unmarshal([]byte(`{"asset_id": "my-mysql-db", "managed_by": "someone@email.com"}`), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("Should have parsed the configuration: %s", err)
+	}
+	d := r.Data(&terraform.InstanceState{
+		ID:         "my-mysql-db",
+		Attributes: map[string]string{"asset_id": "my-mysql-db", "managed_by": "someone@email.com"},
+		RawConfig:  rawConfig,
+	})
+
+	client := &Client{config: &Config{}, httpClient: &http.Client{}}
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	if err := applyOwnershipMarker(d, client, &payload); err != nil || payload.Data.AssetData.ManagedBy != "someone@email.com" {
+		t.Errorf("Should not have changed managed_by without workspace_id. Got: %s, %v", payload.Data.AssetData.ManagedBy, err)
+	}
+
+	client.config.WorkspaceID = "team-a/prod"
+	expected := "managed_by is the ownership_field of the provider and must not be set"
+	if err := applyOwnershipMarker(d, client, &payload); err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Should have returned %q. Got: %v", expected, err)
+	}
+
+	// managed_by read from the hub into the state is not configured
+	d = r.Data(&terraform.InstanceState{
+		ID:         "my-mysql-db",
+		Attributes: map[string]string{"asset_id": "my-mysql-db", "managed_by": "someone@email.com"},
+	})
+	if err := applyOwnershipMarker(d, client, &payload); err != nil || payload.Data.AssetData.ManagedBy != "team-a/prod" {
+		t.Errorf("Should have replaced managed_by of the state with the workspace_id. Got: %s, %v", payload.Data.AssetData.ManagedBy, err)
+	}
+}
+
+func TestAssetOwnershipError(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetOwnershipError \n")
+
+	client := &Client{config: &Config{WorkspaceID: "team-a/prod"}, httpClient: &http.Client{}}
+	testCases := []struct {
+		name     string
+		marker   string
+		override bool
+		expected string
+	}{
+		{"owned", "team-a/prod", false, ""},
+		{"other workspace", "team-b/prod", false, `asset my-mysql-db is owned by "team-b/prod" according to managed_by, not by workspace team-a/prod`},
+		{"no marker", "", false, "asset my-mysql-db has no ownership marker in managed_by"},
+		{"other workspace with override", "team-b/prod", true, ""},
+		{"no marker with override", "", true, ""},
+	}
+	for _, tc := range testCases {
+		d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{"override_ownership": tc.override})
+		current := ResourceData{AssetData: AssetData{AssetID: "my-mysql-db", ManagedBy: tc.marker}}
+		err := assetOwnershipError(d, client, current)
+		switch {
+		case tc.expected == "" && err != nil:
+			t.Errorf("%s: should not have returned an error. Got: %s", tc.name, err)
+		case tc.expected != "" && (err == nil || !strings.HasPrefix(err.Error(), tc.expected)):
+			t.Errorf("%s: should have returned %q. Got: %v", tc.name, tc.expected, err)
+		}
+	}
+
+	client.config.WorkspaceID = ""
+	d := resourceDSFDataSource().TestResourceData()
+	if err := assetOwnershipError(d, client, ResourceData{AssetData: AssetData{ManagedBy: "team-b/prod"}}); err != nil {
+		t.Errorf("Should not have checked the ownership without workspace_id. Got: %s", err)
+	}
+}
+
+func TestMergeCurrentAssetOwnership(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestMergeCurrentAssetOwnership \n")
+
+	server, client := testUpdateServer(t)
+	defer server.Close()
+	client.config.WorkspaceID = "team-a/prod"
+	client.config.OwnershipField = "owner_tag"
+
+	config := testExtraDataConfig("", "")
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, config)
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	applyOwnershipMarker(d, client, &payload)
+	expected := "asset my-mysql-db has no ownership marker in owner_tag and is not owned by workspace team-a/prod"
+	if err := mergeCurrentAsset(d, client, dsfDataSourceResourceType, "my-mysql-db", resourceDSFDataSource().Schema, &payload); err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Should have returned %q. Got: %v", expected, err)
+	}
+
+	// taking ownership with override_ownership marks the asset
+	config["override_ownership"] = true
+	d = schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, config)
+	payload = ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	applyOwnershipMarker(d, client, &payload)
+	if err := mergeCurrentAsset(d, client, dsfDataSourceResourceType, "my-mysql-db", resourceDSFDataSource().Schema, &payload); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	extra := payload.Data.AssetData.Extra
+	if extra["owner_tag"] != "team-a/prod" || extra["hub_field"] != "set in the UI" {
+		t.Errorf("Should have written owner_tag and kept hub_field. Got: %v", extra)
+	}
+}
+
+func TestAdoptExistingAssetOwnership(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAdoptExistingAssetOwnership \n")
+
+	var updated ResourceWrapper
+	server, client := testAdoptServer(t, &updated)
+	defer server.Close()
+	client.config.WorkspaceID = "team-a/prod"
+	client.config.AdoptManagedBy = "terraform@email.com"
+
+	config := testExtraDataConfig("", "")
+	config["adopt_existing"] = true
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, config)
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	applyOwnershipMarker(d, client, &payload)
+
+	createErr := testAlreadyExistsError()
+	if _, err := adoptExistingAsset(context.Background(), d, client, dsfDataSourceResourceType, resourceDSFDataSource().Schema, payload, createErr); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if updated.Data.AssetData.ManagedBy != "team-a/prod" {
+		t.Errorf("Should have marked the adopted asset as owned by the workspace. Got: %s", updated.Data.AssetData.ManagedBy)
+	}
+}
+
+func TestAssetDataField(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetDataField \n")
+
+	assetData := AssetData{
+		ManagedBy: "team-a/prod",
+		Extra:     map[string]interface{}{"owner_tag": "team-b/prod", "null_tag": nil, "empty_tag": map[string]interface{}{}},
+	}
+	testCases := []struct {
+		key      string
+		expected string
+	}{
+		{"managed_by", "team-a/prod"},
+		{"owner_tag", "team-b/prod"},
+		{"archive", ""},
+		{"null_tag", ""},
+		{"empty_tag", ""},
+		{"missing_tag", ""},
+	}
+	for _, tc := range testCases {
+		if value := assetDataField(assetData, tc.key); value != tc.expected {
+			t.Errorf("%s should be %q. Got: %q", tc.key, tc.expected, value)
+		}
+	}
+
+	client := &Client{config: &Config{WorkspaceID: "team-a/prod", OwnershipField: "null_tag"}, httpClient: &http.Client{}}
+	expected := "asset my-mysql-db has no ownership marker in null_tag"
+	d := resourceDSFDataSource().TestResourceData()
+	current := ResourceData{AssetData: AssetData{AssetID: "my-mysql-db", Extra: assetData.Extra}}
+	if err := assetOwnershipError(d, client, current); err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Should have returned %q. Got: %v", expected, err)
+	}
+}
+
+func TestValidateOwnershipField(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestValidateOwnershipField \n")
+
+	for _, field := range []string{"managed_by", "owned_by", "my_custom_tag"} {
+		if err := validateOwnershipField(field); err != nil {
+			t.Errorf("%s should be a valid ownership_field. Got: %s", field, err)
+		}
+	}
+	for _, field := range []string{"archive", "audit_pull_enabled", "connections"} {
+		expected := fmt.Sprintf("invalid ownership_field %q", field)
+		if err := validateOwnershipField(field); err == nil || !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("Should have returned %q. Got: %v", expected, err)
+		}
+	}
+
+	config := Config{DSFHUBToken: "good", DSFHUBHost: "http://127.0.0.1:0", OwnershipField: "archive"}
+	if _, err := config.Client(); err == nil || !strings.HasPrefix(err.Error(), `invalid ownership_field "archive"`) {
+		t.Errorf("Should have rejected ownership_field when configuring the provider. Got: %v", err)
+	}
+}
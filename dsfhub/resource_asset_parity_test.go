@@ -0,0 +1,341 @@
+package dsfhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// parityFieldsWithoutAttribute are the payload fields that deliberately have no
+// attribute in any resource
+var parityFieldsWithoutAttribute = map[string]string{
+	"ResourceData.ApplianceID":     "set by the hub",
+	"ResourceData.ApplianceType":   "set by the hub",
+	"ResourceData.AuditState":      "set by the hub, managed by dsfhub_audit_collection",
+	"ResourceData.GatewayName":     "set by the hub from gateway_id",
+	"ResourceData.IsMonitored":     "set by the hub",
+	"ResourceData.RemoteSyncState": "set by the hub, waited on after changes",
+	"ConnectionData.Port":          "not used by any server type supported by the provider",
+	"ConnectionData.ServerIP":      "not used by any server type supported by the provider",
+	"ConnectionData.Url":           "not used by any server type supported by the provider",
+	"AssetData.Extra":              "set from extra_asset_data, read into unmodelled_asset_data",
+	"ConnectionData.Extra":         "set from extra_connection_data, read into unmodelled_connection_data",
+}
+
+// parityAttributesWithoutField are the attributes that configure the provider
+// and are not sent to the hub, or that hold the fields it does not model
+var parityAttributesWithoutField = []string{
+	"adopt_existing",
+	"allow_asset_rename",
+	"asset_checksum",
+	"asset_connection",
+	"audit_reconnect_reason",
+	"deletion_protection",
+	"extra_asset_data",
+	"extra_connection_data",
+	"force_destroy",
+	"override_ownership",
+	"reconnect_on_change",
+	"strict_audit",
+	"unmodelled_asset_data",
+	"unmodelled_connection_data",
+}
+
+// parityResources are the resources sending and reading assets
+var parityResources = []struct {
+	name     string
+	resource func() *schema.Resource
+}{
+	{dsfCloudAccountResourceType, resourceCloudAccount},
+	{dsfDataSourceResourceType, resourceDSFDataSource},
+	{dsfLogAggregatorResourceType, resourceLogAggregator},
+	{dsfSecretManagerResourceType, resourceSecretManager},
+}
+
+// parityStructs are the payload structs with the fields of assetSchemaJson
+// they are mapped with
+func parityStructs(assetSchema AssetSchema) []struct {
+	value  interface{}
+	fields map[string]SchemaField
+} {
+	return []struct {
+		value  interface{}
+		fields map[string]SchemaField
+	}{
+		{ResourceData{}, assetSchema.Details},
+		{AssetData{}, assetSchema.Details},
+		{ConnectionData{}, assetSchema.Connections},
+	}
+}
+
+// isParityProviderAttribute returns whether name is an attribute of the
+// provider that is not sent to the hub
+func isParityProviderAttribute(name string) bool {
+	if contains(parityAttributesWithoutField, name) {
+		return true
+	}
+	for _, field := range writeOnlyCredentialFields {
+		if name == writeOnlyCredentialField(field) || name == writeOnlyCredentialVersionField(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// parityTypeMatches returns whether a payload field of type fieldType can be
+// created from and read into an attribute of attributeSchema
+func parityTypeMatches(fieldType reflect.Type, attributeSchema *schema.Schema) bool {
+	switch attributeSchema.Type {
+	case schema.TypeString:
+		// server_port is sent as a string and read as a number
+		return fieldType.Kind() == reflect.String || fieldType.Kind() == reflect.Interface
+	case schema.TypeInt:
+		return fieldType.Kind() == reflect.Int
+	case schema.TypeFloat:
+		return fieldType.Kind() == reflect.Float64
+	case schema.TypeBool:
+		return fieldType.Kind() == reflect.Bool || fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Bool
+	case schema.TypeMap:
+		return fieldType.Kind() == reflect.Map
+	case schema.TypeList, schema.TypeSet:
+		switch attributeSchema.Elem.(type) {
+		case *schema.Schema:
+			return fieldType.Kind() == reflect.Slice
+		case *schema.Resource:
+			return fieldType.Kind() == reflect.Ptr && fieldType.Elem().Kind() == reflect.Struct
+		}
+	}
+	return false
+}
+
+func TestAssetFieldParity(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetFieldParity \n")
+
+	assetSchema := getSchema(embeddedAssetDefinitions)
+
+	// every field of the payload is in assetSchemaJson
+	mappedFields := map[string]bool{}
+	for _, s := range parityStructs(assetSchema) {
+		structType := reflect.TypeOf(s.value)
+		for i := 0; i < structType.NumField(); i++ {
+			structField := structType.Field(i)
+			name := structType.Name() + "." + structField.Name
+			switch structField.Name {
+			case "AssetData", "Connections":
+				continue
+			}
+			if _, found := s.fields[structField.Name]; found {
+				mappedFields[fmt.Sprintf("%p.%s", s.fields, structField.Name)] = true
+				continue
+			}
+			if _, found := parityFieldsWithoutAttribute[name]; !found {
+				t.Errorf("%s has no entry in assetSchemaJson", name)
+			}
+		}
+	}
+
+	// every entry of assetSchemaJson is a field of the payload
+	for _, s := range []struct {
+		name   string
+		fields map[string]SchemaField
+	}{{"details", assetSchema.Details}, {"connections", assetSchema.Connections}} {
+		for name := range s.fields {
+			if !mappedFields[fmt.Sprintf("%p.%s", s.fields, name)] && name != "Reason" {
+				t.Errorf("assetSchemaJson %s entry %s is not a field of the payload", s.name, name)
+			}
+		}
+	}
+
+	// every entry of assetSchemaJson has an attribute with a matching type, and
+	// every attribute has an entry
+	detailAttributes := map[string]bool{}
+	connectionAttributes := map[string]bool{}
+	for _, tc := range parityResources {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceSchema := tc.resource().Schema
+			connectionSchema := resourceSchema["asset_connection"].Elem.(*schema.Resource).Schema
+
+			for _, s := range parityStructs(assetSchema) {
+				attributes, found := resourceSchema, detailAttributes
+				if reflect.TypeOf(s.value) == reflect.TypeOf(ConnectionData{}) {
+					attributes, found = connectionSchema, connectionAttributes
+				}
+				structType := reflect.TypeOf(s.value)
+				for i := 0; i < structType.NumField(); i++ {
+					schemaField, mapped := s.fields[structType.Field(i).Name]
+					if !mapped {
+						continue
+					}
+					attributeSchema, ok := attributes[schemaField.ID]
+					if !ok {
+						continue
+					}
+					found[schemaField.ID] = true
+					if !parityTypeMatches(structType.Field(i).Type, attributeSchema) {
+						t.Errorf("%s.%s of type %s does not match attribute %s of type %s", structType.Name(), structType.Field(i).Name, structType.Field(i).Type, schemaField.ID, attributeSchema.Type)
+					}
+				}
+			}
+
+			ids := map[string]bool{"reason": true}
+			for _, field := range assetSchema.Details {
+				ids[field.ID] = true
+			}
+			for name := range resourceSchema {
+				if !ids[name] && !isParityProviderAttribute(name) {
+					t.Errorf("attribute %s has no entry in assetSchemaJson details", name)
+				}
+			}
+			ids = map[string]bool{"reason": true}
+			for _, field := range assetSchema.Connections {
+				ids[field.ID] = true
+			}
+			for name := range connectionSchema {
+				if !ids[name] && !isParityProviderAttribute(name) {
+					t.Errorf("asset_connection attribute %s has no entry in assetSchemaJson connections", name)
+				}
+			}
+		})
+	}
+
+	for _, s := range parityStructs(assetSchema) {
+		attributes := detailAttributes
+		if reflect.TypeOf(s.value) == reflect.TypeOf(ConnectionData{}) {
+			attributes = connectionAttributes
+		}
+		structType := reflect.TypeOf(s.value)
+		for i := 0; i < structType.NumField(); i++ {
+			schemaField, mapped := s.fields[structType.Field(i).Name]
+			if _, found := parityFieldsWithoutAttribute[structType.Name()+"."+structType.Field(i).Name]; found {
+				continue
+			}
+			if mapped && !attributes[schemaField.ID] {
+				t.Errorf("%s.%s is mapped to %s, which is not an attribute of any resource", structType.Name(), structType.Field(i).Name, schemaField.ID)
+			}
+		}
+	}
+}
+
+// parityValue returns a value to configure an attribute of attributeSchema
+// with, which the hub returns unchanged
+func parityValue(name string, attributeSchema *schema.Schema) interface{} {
+	switch attributeSchema.Type {
+	case schema.TypeString:
+		return "value-of-" + name
+	case schema.TypeInt:
+		return 7
+	case schema.TypeFloat:
+		return 1.5
+	case schema.TypeBool:
+		return true
+	case schema.TypeMap:
+		return map[string]interface{}{"key": "value-of-" + name}
+	case schema.TypeList, schema.TypeSet:
+		switch elem := attributeSchema.Elem.(type) {
+		case *schema.Schema:
+			return []interface{}{parityValue(name, elem)}
+		case *schema.Resource:
+			block := map[string]interface{}{}
+			for nestedName, nestedSchema := range elem.Schema {
+				block[nestedName] = parityValue(nestedName, nestedSchema)
+			}
+			return []interface{}{block}
+		}
+	}
+	return nil
+}
+
+// parityConfigurable returns whether an attribute mapped to the payload can be
+// set in the configuration
+func parityConfigurable(name string, attributeSchema *schema.Schema) bool {
+	return (attributeSchema.Optional || attributeSchema.Required) && !isParityProviderAttribute(name)
+}
+
+// parityState returns the value of an attribute in d, with the blocks of sets
+// as lists so that they can be compared
+func parityState(d *schema.ResourceData, name string) interface{} {
+	if set, ok := d.Get(name).(*schema.Set); ok {
+		return set.List()
+	}
+	return d.Get(name)
+}
+
+func TestAssetFieldRoundTrip(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetFieldRoundTrip \n")
+
+	for _, tc := range parityResources {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceSchema := tc.resource().Schema
+			connectionSchema := resourceSchema["asset_connection"].Elem.(*schema.Resource).Schema
+
+			// config
+			config := map[string]interface{}{}
+			var names []string
+			for name, attributeSchema := range resourceSchema {
+				if parityConfigurable(name, attributeSchema) {
+					config[name] = parityValue(name, attributeSchema)
+					names = append(names, name)
+				}
+			}
+			connection := map[string]interface{}{}
+			for name, attributeSchema := range connectionSchema {
+				if parityConfigurable(name, attributeSchema) {
+					connection[name] = parityValue(name, attributeSchema)
+					names = append(names, "asset_connection.0."+name)
+				}
+			}
+			config["asset_connection"] = []interface{}{connection}
+			sort.Strings(names)
+			created := schema.TestResourceDataRaw(t, resourceSchema, config)
+
+			// payload
+			asset := ResourceWrapper{}
+			createResource(&asset, config["server_type"].(string), created, embeddedAssetDefinitions)
+			payload, err := json.Marshal(asset)
+			if err != nil {
+				t.Fatalf("Should have marshalled the payload: %s", err)
+			}
+
+			// response
+			response := ResourceWrapper{}
+			if err := json.Unmarshal(payload, &response); err != nil {
+				t.Fatalf("Should have unmarshalled the response: %s", err)
+			}
+
+			// state
+			d := tc.resource().TestResourceData()
+			if diags := flattenAsset(d, resourceSchema, response.Data, embeddedAssetDefinitions); diags.HasError() {
+				t.Fatalf("Should not have received an error: %v", diags)
+			}
+
+			for _, name := range names {
+				if got, expected := parityState(d, name), parityState(created, name); !reflect.DeepEqual(got, expected) {
+					t.Errorf("Should have read back %s as %v. Got: %v", name, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestArchiveFalseIsSent(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestArchiveFalseIsSent \n")
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"archive":     false,
+		"asset_id":    "my-mysql-db",
+		"server_type": "MYSQL",
+	})
+	asset := ResourceWrapper{}
+	createResource(&asset, "MYSQL", d, embeddedAssetDefinitions)
+	if asset.Data.AssetData.Archive == nil || *asset.Data.AssetData.Archive {
+		t.Errorf("Should have sent archive as false. Got: %v", asset.Data.AssetData.Archive)
+	}
+}
@@ -0,0 +1,200 @@
+package dsfhub
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// assetReferenceResourceTypes is the order in which resource types are looked
+// up when resolving a reference to another asset
+var assetReferenceResourceTypes = []string{
+	dsfCloudAccountResourceType,
+	dsfDataSourceResourceType,
+	dsfLogAggregatorResourceType,
+	dsfSecretManagerResourceType,
+}
+
+// assetReferenceKinds describes resource types in error messages
+var assetReferenceKinds = map[string]string{
+	dsfCloudAccountResourceType:  "cloud account",
+	dsfDataSourceResourceType:    "data source",
+	dsfLogAggregatorResourceType: "log aggregator",
+	dsfSecretManagerResourceType: "secret manager",
+}
+
+// secretManagerVendors maps each asset_connection secret block to the
+// server_type of the secret manager it must reference
+var secretManagerVendors = map[string]string{
+	"amazon_secret":    "AWS",
+	"cyberark_secret":  "CYBERARK",
+	"hashicorp_secret": "HASHICORP",
+}
+
+// cloudAccountServerTypes lists the server types of cloud accounts, which are
+// also the prefix of the server type of the assets hosted on each cloud
+var cloudAccountServerTypes = []string{"ALIBABA", "AWS", "AZURE", "GCP"}
+
+// assetReference is a field holding the asset_id of another asset
+type assetReference struct {
+	Field   string
+	AssetId string
+	Path    cty.Path
+	// Allowed maps the resource types the field may reference to the server
+	// types allowed for each of them, nil allowing any server type
+	Allowed map[string][]string
+}
+
+// serverTypeCloud returns the cloud hosting assets of the server type, or an
+// empty string for assets not hosted on a supported cloud
+func serverTypeCloud(serverType string) string {
+	for _, cloud := range cloudAccountServerTypes {
+		if serverType == cloud || strings.HasPrefix(serverType, cloud+" ") {
+			return cloud
+		}
+	}
+	return ""
+}
+
+// assetReferences returns the references to other assets set on the resource
+func assetReferences(d *schema.ResourceData) []assetReference {
+	var references []assetReference
+
+	// the parent of an asset hosted on a cloud must be a cloud account of the
+	// same cloud, or another asset such as a cluster
+	cloudAccounts := []string(nil)
+	if cloud := serverTypeCloud(d.Get("server_type").(string)); cloud != "" {
+		cloudAccounts = []string{cloud}
+	}
+	if v, ok := d.GetOk("parent_asset_id"); ok {
+		references = append(references, assetReference{
+			Field:   "parent_asset_id",
+			AssetId: v.(string),
+			Path:    cty.GetAttrPath("parent_asset_id"),
+			Allowed: map[string][]string{
+				dsfCloudAccountResourceType:  cloudAccounts,
+				dsfDataSourceResourceType:    nil,
+				dsfLogAggregatorResourceType: nil,
+			},
+		})
+	}
+	if v, ok := d.GetOk("logs_destination_asset_id"); ok {
+		references = append(references, assetReference{
+			Field:   "logs_destination_asset_id",
+			AssetId: v.(string),
+			Path:    cty.GetAttrPath("logs_destination_asset_id"),
+			Allowed: map[string][]string{dsfLogAggregatorResourceType: nil},
+		})
+	}
+	if v, ok := d.GetOk("application"); ok {
+		references = append(references, assetReference{
+			Field:   "application",
+			AssetId: v.(string),
+			Path:    cty.GetAttrPath("application"),
+			Allowed: map[string][]string{
+				dsfCloudAccountResourceType:  nil,
+				dsfDataSourceResourceType:    nil,
+				dsfLogAggregatorResourceType: nil,
+				dsfSecretManagerResourceType: nil,
+			},
+		})
+	}
+
+	connections, ok := d.Get("asset_connection").([]interface{})
+	if !ok {
+		return references
+	}
+	for i, conn := range connections {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := connection["aws_connection_id"].(string); ok && v != "" {
+			references = append(references, assetReference{
+				Field:   "asset_connection.aws_connection_id",
+				AssetId: v,
+				Path:    cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr("aws_connection_id"),
+				Allowed: map[string][]string{dsfCloudAccountResourceType: {"AWS"}},
+			})
+		}
+		for _, block := range []string{"amazon_secret", "cyberark_secret", "hashicorp_secret"} {
+			vendor := secretManagerVendors[block]
+			secrets, ok := connection[block].(*schema.Set)
+			if !ok {
+				continue
+			}
+			for _, s := range secrets.List() {
+				secret := s.(map[string]interface{})
+				if v, ok := secret["secret_asset_id"].(string); ok && v != "" {
+					references = append(references, assetReference{
+						Field:   fmt.Sprintf("asset_connection.%s.secret_asset_id", block),
+						AssetId: v,
+						Path:    cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr(block),
+						Allowed: map[string][]string{dsfSecretManagerResourceType: {vendor}},
+					})
+				}
+			}
+		}
+	}
+	return references
+}
+
+// validateAssetReference reads the asset referenced by a field and returns an
+// error if it does not exist or is not of an allowed kind
+func validateAssetReference(client Client, reference assetReference) error {
+	var kinds []string
+	var lastErr error
+	for _, resourceType := range assetReferenceResourceTypes {
+		serverTypes, allowed := reference.Allowed[resourceType]
+		if !allowed {
+			continue
+		}
+		kind := assetReferenceKinds[resourceType]
+		if len(serverTypes) > 0 {
+			kind = fmt.Sprintf("%s %s", strings.Join(serverTypes, "/"), kind)
+		}
+		kinds = append(kinds, kind)
+
+		result, err := readAsset(client, resourceType, reference.AssetId)
+		if err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("error reading %s %q to validate it | err: %s", reference.Field, reference.AssetId, err)
+		}
+		if err != nil {
+			log.Printf("[DEBUG] %s %q is not a %s: %s\n", reference.Field, reference.AssetId, assetReferenceKinds[resourceType], err)
+			lastErr = err
+			continue
+		}
+		if len(serverTypes) > 0 && !contains(serverTypes, result.Data.ServerType) {
+			return fmt.Errorf("%s %q references a %s with serverType '%s', expected a %s", reference.Field, reference.AssetId, assetReferenceKinds[resourceType], result.Data.ServerType, kind)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s %q does not reference an existing %s: %v", reference.Field, reference.AssetId, strings.Join(kinds, " or "), lastErr)
+}
+
+// validateAssetReferences checks that every asset referenced by the resource
+// exists and is of the right kind, returning one diagnostic per invalid
+// reference. On update, only references that have changed are checked.
+func validateAssetReferences(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	var diags diag.Diagnostics
+	for _, reference := range assetReferences(d) {
+		if d.Id() != "" && !d.HasChange(strings.Split(reference.Field, ".")[0]) {
+			continue
+		}
+		if err := validateAssetReference(*client, reference); err != nil {
+			log.Printf("[ERROR] Invalid asset reference | err: %s\n", err)
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid %s", reference.Field),
+				Detail:        err.Error(),
+				AttributePath: reference.Path,
+			})
+		}
+	}
+	return diags
+}
@@ -0,0 +1,146 @@
+package dsfhub
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newAssetReferenceTestServer returns a server that knows about an AWS cloud
+// account, an AZURE cloud account, a HASHICORP secret manager and a log
+// aggregator, and returns a not found error for any other asset
+func newAssetReferenceTestServer(t *testing.T) *httptest.Server {
+	assets := map[string]string{
+		baseAPIPrefix + endpointCloudAccounts + "/aws-account":        "AWS",
+		baseAPIPrefix + endpointCloudAccounts + "/azure-account":      "AZURE",
+		baseAPIPrefix + endpointSecretManagers + "/hashicorp-vault":   "HASHICORP",
+		baseAPIPrefix + endpointLogAggregators + "/my-log-aggregator": "AWS LOG GROUP",
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		serverType, found := assets[req.URL.String()]
+		if !found {
+			rw.WriteHeader(404)
+			rw.Write([]byte(fmt.Sprintf(`{"errors":[{"status":404,"title":"Not Found","detail":"Asset not found: %s"}]}`, req.URL.String())))
+			return
+		}
+		rw.Write([]byte(fmt.Sprintf(`{"data":{"serverType":"%s","assetData":{}}}`, serverType)))
+	}))
+}
+
+func TestValidateAssetReferencesValid(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestValidateAssetReferencesValid \n")
+
+	server := newAssetReferenceTestServer(t)
+	defer server.Close()
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"logs_destination_asset_id": "my-log-aggregator",
+		"parent_asset_id":           "aws-account",
+		"server_type":               "AWS RDS MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism": "password",
+				"reason":         "default",
+				"hashicorp_secret": []interface{}{
+					map[string]interface{}{
+						"secret_asset_id": "hashicorp-vault",
+					},
+				},
+			},
+		},
+	})
+
+	diags := validateAssetReferences(d, client)
+	if diags.HasError() {
+		t.Errorf("Should not have received an error: %v", diags)
+	}
+}
+
+func TestValidateAssetReferencesInvalid(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestValidateAssetReferencesInvalid \n")
+
+	server := newAssetReferenceTestServer(t)
+	defer server.Close()
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"logs_destination_asset_id": "aws-account",
+		"parent_asset_id":           "azure-account",
+		"server_type":               "AWS RDS MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism": "password",
+				"reason":         "sonargateway",
+			},
+			map[string]interface{}{
+				"auth_mechanism":    "password",
+				"aws_connection_id": "missing-account",
+				"reason":            "default",
+				"amazon_secret": []interface{}{
+					map[string]interface{}{
+						"secret_asset_id": "hashicorp-vault",
+					},
+				},
+			},
+		},
+	})
+
+	diags := validateAssetReferences(d, client)
+	expected := map[string]struct {
+		detail string
+		path   cty.Path
+	}{
+		"Invalid parent_asset_id":                                {"parent_asset_id \"azure-account\" references a cloud account with serverType 'AZURE', expected a AWS cloud account", cty.GetAttrPath("parent_asset_id")},
+		"Invalid logs_destination_asset_id":                      {"logs_destination_asset_id \"aws-account\" does not reference an existing log aggregator", cty.GetAttrPath("logs_destination_asset_id")},
+		"Invalid asset_connection.aws_connection_id":             {"asset_connection.aws_connection_id \"missing-account\" does not reference an existing AWS cloud account", cty.GetAttrPath("asset_connection").IndexInt(1).GetAttr("aws_connection_id")},
+		"Invalid asset_connection.amazon_secret.secret_asset_id": {"asset_connection.amazon_secret.secret_asset_id \"hashicorp-vault\" references a secret manager with serverType 'HASHICORP', expected a AWS secret manager", cty.GetAttrPath("asset_connection").IndexInt(1).GetAttr("amazon_secret")},
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("Should have received %d errors. Got: %v", len(expected), diags)
+	}
+	for _, d := range diags {
+		e, found := expected[d.Summary]
+		if !found {
+			t.Errorf("Should not have received error %q", d.Summary)
+			continue
+		}
+		if !strings.HasPrefix(d.Detail, e.detail) {
+			t.Errorf("%s should have started with %q. Got: %q", d.Summary, e.detail, d.Detail)
+		}
+		if !d.AttributePath.Equals(e.path) {
+			t.Errorf("%s should have been reported for %#v. Got: %#v", d.Summary, e.path, d.AttributePath)
+		}
+	}
+}
+
+func TestValidateAssetReferenceReadError(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestValidateAssetReferenceReadError \n")
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(503)
+		rw.Write([]byte(`{"errors":[{"status":503,"title":"Service Unavailable"}]}`))
+	}))
+	defer server.Close()
+	client := Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+
+	reference := assetReference{
+		Field:   "logs_destination_asset_id",
+		AssetId: "my-log-aggregator",
+		Allowed: map[string][]string{dsfLogAggregatorResourceType: nil},
+	}
+	expected := `error reading logs_destination_asset_id "my-log-aggregator" to validate it`
+	if err := validateAssetReference(client, reference); err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Should have returned %q instead of a missing asset. Got: %v", expected, err)
+	}
+}
@@ -0,0 +1,195 @@
+package dsfhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// assetIdentityCustomizeDiff replaces the asset when its asset_id changes,
+// unless allow_asset_rename is set in which case the asset is recreated under
+// its new asset_id by renameAsset. Changes to server_type always replace the asset.
+func assetIdentityCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("asset_id") {
+		return nil
+	}
+	if d.Get("allow_asset_rename").(bool) {
+		log.Printf("[DEBUG] asset_id has changed, asset %s will be renamed\n", d.Id())
+		return nil
+	}
+	return d.ForceNew("asset_id")
+}
+
+// createAsset creates an asset of any resource type
+func createAsset(client Client, resourceType string, asset ResourceWrapper) (*ResourceWrapper, error) {
+	createFuncs := map[string]func(ResourceWrapper) (*ResourceWrapper, error){
+		dsfDataSourceResourceType:    client.CreateDSFDataSource,
+		dsfLogAggregatorResourceType: client.CreateLogAggregator,
+		dsfCloudAccountResourceType:  client.CreateCloudAccount,
+		dsfSecretManagerResourceType: client.CreateSecretManager,
+	}
+
+	createFn, ok := createFuncs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("invalid resourceType: %v", resourceType)
+	}
+
+	log.Printf("[INFO] creating %s asset %v", resourceType, asset.Data.AssetData.AssetID)
+	return createFn(asset)
+}
+
+// deleteAsset deletes an asset of any resource type
+func deleteAsset(client Client, resourceType string, assetId string) error {
+	deleteFuncs := map[string]func(string) (*ResourceResponse, error){
+		dsfDataSourceResourceType:    client.DeleteDSFDataSource,
+		dsfLogAggregatorResourceType: client.DeleteLogAggregator,
+		dsfCloudAccountResourceType:  client.DeleteCloudAccount,
+		dsfSecretManagerResourceType: client.DeleteSecretManager,
+	}
+
+	deleteFn, ok := deleteFuncs[resourceType]
+	if !ok {
+		return fmt.Errorf("invalid resourceType: %v", resourceType)
+	}
+
+	log.Printf("[INFO] deleting %s asset %v", resourceType, assetId)
+	_, err := deleteFn(assetId)
+	return err
+}
+
+// readDependentAssets returns the assets that reference oldAssetId, read
+// again one by one so that they are updated from their current state rather
+// than from the summary returned by the list endpoints. An error is returned if
+// one of them is not owned by the workspace_id of the provider.
+func readDependentAssets(client Client, oldAssetId string) ([]dependentAsset, error) {
+	dependents, err := dependentAssets(client, oldAssetId)
+	if err != nil {
+		return nil, fmt.Errorf("error looking for assets referencing asset %s | err: %s", oldAssetId, err)
+	}
+	for i, dependent := range dependents {
+		dependentId := dependent.Asset.AssetData.AssetID
+		current, err := readAsset(client, dependent.ResourceType, dependentId)
+		if err != nil {
+			return nil, fmt.Errorf("error reading asset %s, which references asset %s | err: %s", dependentId, oldAssetId, err)
+		}
+		if err := workspaceOwnershipError(client.config, current.Data); err != nil {
+			return nil, fmt.Errorf("%s, it references asset %s and cannot be pointed at its new asset_id", err, oldAssetId)
+		}
+		dependents[i].Asset = current.Data
+	}
+	return dependents, nil
+}
+
+// repointDependentAssets points the references to oldAssetId of the dependent
+// assets at newAssetId, returning the dependents that were updated
+func repointDependentAssets(client Client, dependents []dependentAsset, oldAssetId string, newAssetId string) ([]dependentAsset, error) {
+	var repointed []dependentAsset
+	for _, dependent := range dependents {
+		dependentId := dependent.Asset.AssetData.AssetID
+		payload := currentAssetPayload(dependent.Asset, client.assetDefinitions())
+		for i := range payload.Data.AssetData.Connections {
+			// the secrets are copied before their secret_asset_id is changed
+			connectionData := &payload.Data.AssetData.Connections[i].ConnectionData
+			for _, secret := range []**Secret{&connectionData.AmazonSecret, &connectionData.CyberarkSecret, &connectionData.HashicorpSecret} {
+				if *secret != nil {
+					secretCopy := **secret
+					*secret = &secretCopy
+				}
+			}
+		}
+		for _, reference := range assetReferenceValues(&payload.Data) {
+			if *reference.Value == oldAssetId {
+				*reference.Value = newAssetId
+			}
+		}
+		log.Printf("[INFO] Updating %s of asset %s from %s to %s\n", strings.Join(dependent.Fields, ", "), dependentId, oldAssetId, newAssetId)
+		if _, err := updateAsset(client, dependent.ResourceType, dependentId, payload); err != nil {
+			return repointed, fmt.Errorf("error updating %s of asset %s | err: %s", strings.Join(dependent.Fields, ", "), dependentId, err)
+		}
+		repointed = append(repointed, dependent)
+	}
+	return repointed, nil
+}
+
+// rollbackRename undoes a rename that failed before the old asset was
+// deleted: the repointed dependents are updated back to their state before the
+// rename and the new asset is deleted. err, the error of the rename, is
+// returned along with the errors of the rollback.
+func rollbackRename(client Client, resourceType string, oldAssetId string, newAssetId string, repointed []dependentAsset, err error) error {
+	log.Printf("[WARN] Rolling back renaming asset %s to %s | err: %s\n", oldAssetId, newAssetId, err)
+	var rollbackErrs []string
+	for _, dependent := range repointed {
+		dependentId := dependent.Asset.AssetData.AssetID
+		if _, updateErr := updateAsset(client, dependent.ResourceType, dependentId, currentAssetPayload(dependent.Asset, client.assetDefinitions())); updateErr != nil {
+			rollbackErrs = append(rollbackErrs, fmt.Sprintf("pointing asset %s back at %s: %s", dependentId, oldAssetId, updateErr))
+		}
+	}
+	deleteErr := deleteAsset(client, resourceType, newAssetId)
+	if deleteErr = deleteAssetError(client, resourceType, newAssetId, deleteErr); deleteErr != nil {
+		rollbackErrs = append(rollbackErrs, deleteErr.Error())
+	}
+	if len(rollbackErrs) > 0 {
+		return fmt.Errorf("%s. Rolling back the rename failed, fix the assets on the DSF Hub before applying again: %s", err, strings.Join(rollbackErrs, "; "))
+	}
+	return err
+}
+
+// renameAsset moves the asset oldAssetId to the asset_id of asset. The hub has
+// no rename operation, so the asset is created with its new asset_id, the
+// assets referencing the old asset are pointed at the new one, and the old
+// asset is deleted. Audit collection is disabled on the old asset before it is
+// deleted, and is left disabled on the new asset for the caller to enable. If
+// a step fails before the old asset is deleted, the rename is rolled back,
+// leaving audit collection disabled on the old asset for the next apply to
+// enable again. This is a recreate with dependent repointing: the audit
+// history and gateway state tied to the old asset_id are lost.
+func renameAsset(ctx context.Context, m interface{}, resourceType string, oldAssetId string, asset ResourceWrapper) error {
+	client := m.(*Client)
+	newAssetId := asset.Data.AssetData.AssetID
+
+	// the dependents are read and checked before anything is changed
+	dependents, err := readDependentAssets(*client, oldAssetId)
+	if err != nil {
+		return fmt.Errorf("error renaming asset %s to %s: %s", oldAssetId, newAssetId, err)
+	}
+
+	asset.Data.AssetData.AuditPullEnabled = false
+	log.Printf("[INFO] Renaming %s asset %s to %s\n", resourceType, oldAssetId, newAssetId)
+	if _, err := createAsset(*client, resourceType, asset); err != nil {
+		return fmt.Errorf("error creating asset %s to rename asset %s | err: %s", newAssetId, oldAssetId, err)
+	}
+
+	repointed, err := repointDependentAssets(*client, dependents, oldAssetId, newAssetId)
+	if err != nil {
+		return rollbackRename(*client, resourceType, oldAssetId, newAssetId, repointed, fmt.Errorf("error pointing the assets referencing asset %s at renamed asset %s | err: %s", oldAssetId, newAssetId, err))
+	}
+
+	oldAsset, err := readAsset(*client, resourceType, oldAssetId)
+	if err != nil {
+		return rollbackRename(*client, resourceType, oldAssetId, newAssetId, repointed, fmt.Errorf("error reading asset %s to rename it to %s | err: %s", oldAssetId, newAssetId, err))
+	}
+	if oldAsset.Data.AssetData.AuditPullEnabled {
+		if err := disconnectGateway(ctx, m, oldAssetId, resourceType); err != nil {
+			return rollbackRename(*client, resourceType, oldAssetId, newAssetId, repointed, fmt.Errorf("error disconnecting asset %s before deleting it | err: %s", oldAssetId, err))
+		}
+	}
+
+	if err := deleteAssetError(*client, resourceType, oldAssetId, deleteAsset(*client, resourceType, oldAssetId)); err != nil {
+		return rollbackRename(*client, resourceType, oldAssetId, newAssetId, repointed, fmt.Errorf("error deleting asset %s after renaming it to %s | err: %s", oldAssetId, newAssetId, err))
+	}
+	return nil
+}
+
+// renameCurrentAsset renames the asset oldAssetId to the asset_id of payload,
+// built from the configuration by createResource. Like an update, payload is
+// overlaid over the asset as currently read from the hub by mergeCurrentAsset,
+// so that the renamed asset keeps the fields not managed by Terraform.
+func renameCurrentAsset(ctx context.Context, d *schema.ResourceData, m interface{}, resourceType string, oldAssetId string, resourceSchema map[string]*schema.Schema, payload ResourceWrapper) error {
+	if err := mergeCurrentAsset(d, m, resourceType, oldAssetId, resourceSchema, &payload); err != nil {
+		return err
+	}
+	return renameAsset(ctx, m, resourceType, oldAssetId, payload)
+}
@@ -0,0 +1,285 @@
+package dsfhub
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAssetIdentityCustomizeDiff(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetIdentityCustomizeDiff \n")
+
+	state := &terraform.InstanceState{
+		ID: "old-asset-id",
+		Attributes: map[string]string{
+			"admin_email":        testAdminEmail,
+			"asset_display_name": "my-mysql-db",
+			"asset_id":           "old-asset-id",
+			"gateway_id":         "my-gateway",
+			"id":                 "old-asset-id",
+			"server_host_name":   "mydbhost",
+			"server_ip":          "10.0.0.1",
+			"server_type":        "MYSQL",
+		},
+	}
+
+	for _, allowAssetRename := range []bool{false, true} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"admin_email":        testAdminEmail,
+			"allow_asset_rename": allowAssetRename,
+			"asset_display_name": "my-mysql-db",
+			"asset_id":           "new-asset-id",
+			"gateway_id":         "my-gateway",
+			"server_host_name":   "mydbhost",
+			"server_ip":          "10.0.0.1",
+			"server_type":        "MYSQL",
+		})
+
+		diff, err := resourceDSFDataSource().SimpleDiff(context.Background(), state, config, &Client{config: &Config{}})
+		if err != nil {
+			t.Fatalf("Should not have received an error: %s", err)
+		}
+		if diff.RequiresNew() == allowAssetRename {
+			t.Errorf("With allow_asset_rename = %v, replacing the data source should be %v. Got: %v", allowAssetRename, !allowAssetRename, diff.RequiresNew())
+		}
+	}
+}
+
+func TestServerTypeForcesNew(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestServerTypeForcesNew \n")
+
+	resources := map[string]bool{
+		dsfCloudAccountResourceType:  resourceCloudAccount().Schema["server_type"].ForceNew,
+		dsfDataSourceResourceType:    resourceDSFDataSource().Schema["server_type"].ForceNew,
+		dsfLogAggregatorResourceType: resourceLogAggregator().Schema["server_type"].ForceNew,
+		dsfSecretManagerResourceType: resourceSecretManager().Schema["server_type"].ForceNew,
+	}
+	for resourceType, forceNew := range resources {
+		if !forceNew {
+			t.Errorf("Changing the server_type of a %s should replace it", resourceType)
+		}
+	}
+}
+
+// testRenameServer returns a hub on which the cloud account old-account is
+// renamed, referenced by the data source my-db. The assets updated are stored
+// in updated by asset_id, and updating my-db fails with failUpdate.
+func testRenameServer(t *testing.T, calls *[]string, updated map[string]ResourceWrapper, failUpdate bool) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		*calls = append(*calls, req.Method+" "+req.URL.Path)
+		body, _ := ioutil.ReadAll(req.Body)
+		switch req.Method + " " + req.URL.Path {
+		case http.MethodPost + " " + baseAPIPrefix + endpointCloudAccounts:
+			var created ResourceWrapper
+			json.Unmarshal(body, &created)
+			updated[created.Data.AssetData.AssetID] = created
+			rw.Write(body)
+		case http.MethodGet + " " + baseAPIPrefix + endpointDsfDataSource:
+			// the list endpoints return a summary of the assets
+			rw.Write([]byte(`{"data":[{"serverType":"AWS RDS MYSQL","parentAssetId":"old-account","assetData":{"asset_id":"my-db","parent_asset_id":"old-account"}},{"serverType":"MYSQL","assetData":{"asset_id":"other-db"}}]}`))
+		case http.MethodGet + " " + baseAPIPrefix + endpointDsfDataSource + "/my-db":
+			rw.Write([]byte(`{"data":{"serverType":"AWS RDS MYSQL","parentAssetId":"old-account","remoteSyncState":"SYNCED","assetData":{"asset_id":"my-db","parent_asset_id":"old-account","managed_by":"team-b/prod","connections":[{"reason":"default","connectionData":{"username":"admin","password":"*****","aws_connection_id":"old-account","amazon_secret":{"secret_asset_id":"old-account","secret_name":"my-secret"}}}]}}}`))
+		case http.MethodPut + " " + baseAPIPrefix + endpointDsfDataSource + "/my-db":
+			if failUpdate {
+				rw.WriteHeader(500)
+				rw.Write([]byte(`{"errors":[{"status":500,"title":"Internal Server Error"}]}`))
+				return
+			}
+			var asset ResourceWrapper
+			json.Unmarshal(body, &asset)
+			updated["my-db"] = asset
+			rw.Write(body)
+		case http.MethodGet + " " + baseAPIPrefix + endpointCloudAccounts + "/old-account":
+			rw.Write([]byte(`{"data":{"serverType":"AWS","assetData":{"asset_id":"old-account"}}}`))
+		case http.MethodDelete + " " + baseAPIPrefix + endpointCloudAccounts + "/old-account",
+			http.MethodDelete + " " + baseAPIPrefix + endpointCloudAccounts + "/new-account":
+			rw.Write([]byte(`{"data":"deleted"}`))
+		default:
+			if req.Method == http.MethodGet {
+				rw.Write([]byte(`{"data":[]}`))
+				return
+			}
+			t.Errorf("Should not have called %s %s", req.Method, req.URL.Path)
+			rw.WriteHeader(404)
+			rw.Write([]byte(`{"errors":[{"status":404,"title":"Not Found"}]}`))
+		}
+	}))
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+	return server, client
+}
+
+// testRenamedAccount returns the cloud account old-account renamed to new-account
+func testRenamedAccount() ResourceWrapper {
+	asset := ResourceWrapper{}
+	asset.Data.ServerType = "AWS"
+	asset.Data.AssetData.AssetID = "new-account"
+	return asset
+}
+
+// testRenameListCalls returns the calls looking for the assets referencing
+// old-account
+func testRenameListCalls() []string {
+	return []string{
+		http.MethodGet + " " + baseAPIPrefix + endpointCloudAccounts,
+		http.MethodGet + " " + baseAPIPrefix + endpointDsfDataSource,
+		http.MethodGet + " " + baseAPIPrefix + endpointLogAggregators,
+		http.MethodGet + " " + baseAPIPrefix + endpointSecretManagers,
+		http.MethodGet + " " + baseAPIPrefix + endpointDsfDataSource + "/my-db",
+	}
+}
+
+func TestRenameAsset(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRenameAsset \n")
+
+	var calls []string
+	updated := map[string]ResourceWrapper{}
+	server, client := testRenameServer(t, &calls, updated, false)
+	defer server.Close()
+
+	err := renameAsset(context.Background(), client, dsfCloudAccountResourceType, "old-account", testRenamedAccount())
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+
+	expectedCalls := append(testRenameListCalls(),
+		http.MethodPost+" "+baseAPIPrefix+endpointCloudAccounts,
+		http.MethodPut+" "+baseAPIPrefix+endpointDsfDataSource+"/my-db",
+		http.MethodGet+" "+baseAPIPrefix+endpointCloudAccounts+"/old-account",
+		http.MethodDelete+" "+baseAPIPrefix+endpointCloudAccounts+"/old-account",
+	)
+	if !reflect.DeepEqual(calls, expectedCalls) {
+		t.Errorf("Should have called %v. Got: %v", expectedCalls, calls)
+	}
+	if _, found := updated["new-account"]; !found {
+		t.Errorf("Should have created asset new-account. Got: %v", updated)
+	}
+
+	repointed := updated["my-db"].Data
+	if repointed.ParentAssetID != "new-account" || repointed.AssetData.ParentAssetID != "new-account" {
+		t.Errorf("Should have pointed parent_asset_id of my-db at new-account. Got: %s, %s", repointed.ParentAssetID, repointed.AssetData.ParentAssetID)
+	}
+	if len(repointed.AssetData.Connections) != 1 {
+		t.Fatalf("Should have kept the connection of my-db. Got: %v", repointed.AssetData.Connections)
+	}
+	connectionData := repointed.AssetData.Connections[0].ConnectionData
+	if connectionData.AwsConnectionID != "new-account" || connectionData.AmazonSecret == nil || connectionData.AmazonSecret.SecretAssetID != "new-account" {
+		t.Errorf("Should have pointed the connection of my-db at new-account. Got: %s, %v", connectionData.AwsConnectionID, connectionData.AmazonSecret)
+	}
+	if connectionData.Username != "admin" || connectionData.Password != "" {
+		t.Errorf("Should have kept the username and left out the masked password of my-db. Got: %q, %q", connectionData.Username, connectionData.Password)
+	}
+	if repointed.RemoteSyncState != "" {
+		t.Errorf("Should not have sent the remoteSyncState set by the hub. Got: %s", repointed.RemoteSyncState)
+	}
+}
+
+func TestRenameAssetDependentOwnership(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRenameAssetDependentOwnership \n")
+
+	var calls []string
+	updated := map[string]ResourceWrapper{}
+	server, client := testRenameServer(t, &calls, updated, false)
+	defer server.Close()
+	client.config.WorkspaceID = "team-a/prod"
+
+	expected := `error renaming asset old-account to new-account: asset my-db is owned by "team-b/prod" according to managed_by, not by workspace team-a/prod`
+	err := renameAsset(context.Background(), client, dsfCloudAccountResourceType, "old-account", testRenamedAccount())
+	if err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Should have returned %q. Got: %v", expected, err)
+	}
+	if !reflect.DeepEqual(calls, testRenameListCalls()) {
+		t.Errorf("Should not have changed any asset. Got: %v", calls)
+	}
+}
+
+func TestRenameAssetRollback(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRenameAssetRollback \n")
+
+	var calls []string
+	updated := map[string]ResourceWrapper{}
+	server, client := testRenameServer(t, &calls, updated, true)
+	defer server.Close()
+
+	expected := "error pointing the assets referencing asset old-account at renamed asset new-account"
+	err := renameAsset(context.Background(), client, dsfCloudAccountResourceType, "old-account", testRenamedAccount())
+	if err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Should have returned %q. Got: %v", expected, err)
+	}
+
+	expectedCalls := append(testRenameListCalls(),
+		http.MethodPost+" "+baseAPIPrefix+endpointCloudAccounts,
+		http.MethodPut+" "+baseAPIPrefix+endpointDsfDataSource+"/my-db",
+		http.MethodDelete+" "+baseAPIPrefix+endpointCloudAccounts+"/new-account",
+	)
+	if !reflect.DeepEqual(calls, expectedCalls) {
+		t.Errorf("Should have deleted new-account and kept old-account. Got: %v", calls)
+	}
+}
+
+func TestRenameCurrentAssetKeepsUnmanagedFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRenameCurrentAssetKeepsUnmanagedFields \n")
+
+	var created ResourceWrapper
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		switch req.Method + " " + req.URL.Path {
+		case http.MethodGet + " " + baseAPIPrefix + endpointCloudAccounts + "/old-account":
+			rw.Write([]byte(`{"data":{"serverType":"AWS","assetData":{"asset_id":"old-account","location":"my-datacenter","hub_field":"set in the UI"}}}`))
+		case http.MethodPost + " " + baseAPIPrefix + endpointCloudAccounts:
+			json.Unmarshal(body, &created)
+			rw.Write(body)
+		case http.MethodDelete + " " + baseAPIPrefix + endpointCloudAccounts + "/old-account":
+			rw.Write([]byte(`{"data":"deleted"}`))
+		default:
+			if req.Method == http.MethodGet {
+				rw.Write([]byte(`{"data":[]}`))
+				return
+			}
+			t.Errorf("Should not have called %s %s", req.Method, req.URL.Path)
+			rw.WriteHeader(404)
+			rw.Write([]byte(`{"errors":[{"status":404,"title":"Not Found"}]}`))
+		}
+	}))
+	defer server.Close()
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+
+	d := schema.TestResourceDataRaw(t, resourceCloudAccount().Schema, map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"allow_asset_rename": true,
+		"asset_display_name": "my-account",
+		"asset_id":           "new-account",
+		"gateway_id":         "my-gateway",
+		"server_type":        "AWS",
+	})
+	payload := ResourceWrapper{}
+	createResource(&payload, "AWS", d, embeddedAssetDefinitions)
+
+	if err := renameCurrentAsset(context.Background(), d, client, dsfCloudAccountResourceType, "old-account", resourceCloudAccount().Schema, payload); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	assetData := created.Data.AssetData
+	if assetData.AssetID != "new-account" || assetData.AdminEmail != testAdminEmail {
+		t.Errorf("Should have created new-account from the configuration. Got: %s, %s", assetData.AssetID, assetData.AdminEmail)
+	}
+	if assetData.Location != "my-datacenter" {
+		t.Errorf("Should have kept location, which is not configured. Got: %s", assetData.Location)
+	}
+	if !reflect.DeepEqual(assetData.Extra, map[string]interface{}{"hub_field": "set in the UI"}) {
+		t.Errorf("Should have kept hub_field, which is not managed by Terraform. Got: %v", assetData.Extra)
+	}
+}
@@ -0,0 +1,83 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maskedSecretPattern matches the value returned by the hub in place of a
+// credential, e.g. "*****"
+var maskedSecretPattern = regexp.MustCompile(`^\*+$`)
+
+// credentialConnectionFields lists the asset_connection fields that the hub
+// returns masked. Each resource marks those it has as sensitive.
+var credentialConnectionFields = []string{
+	"access_key",
+	"api_key",
+	"azure_storage_secret_key",
+	"client_secret",
+	"eventhub_access_key",
+	"jdbc_ssl_trust_store_password",
+	"passphrase",
+	"password",
+	"proxy_password",
+	"secret_key",
+	"service_key",
+	"session_token",
+	"token",
+}
+
+// isMaskedSecret returns true if value is a credential masked by the hub
+func isMaskedSecret(value string) bool {
+	return maskedSecretPattern.MatchString(value)
+}
+
+// suppressMaskedSecretDiff suppresses the diff of a credential whose value in
+// the state is masked, which is the case after an import since the hub does
+// not return the actual value, when the configuration does not set it. A
+// configured credential is always compared, so that it replaces the mask in
+// the state and can be rotated.
+func suppressMaskedSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	return isMaskedSecret(old) && new == ""
+}
+
+// preserveMaskedSecrets replaces the credentials masked by the hub in the
+// asset_connection blocks read from the hub with the values of the connection
+// with the same reason in the state, which are empty for credentials set with
+// their write-only variant. Credentials of connections that are not in the
+// state, e.g. after an import, are kept masked.
+func preserveMaskedSecrets(d *schema.ResourceData, connections []interface{}) []interface{} {
+	priorConnections := priorAssetConnections(d)
+
+	for _, conn := range connections {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		priorConnection, found := priorConnections[connectionReason(connection)]
+		if !found {
+			continue
+		}
+		for _, field := range credentialConnectionFields {
+			value, ok := connection[field].(string)
+			if !ok || !isMaskedSecret(value) {
+				continue
+			}
+			if priorValue, ok := priorConnection[field].(string); ok {
+				log.Printf("[DEBUG] Keeping asset_connection.%s of connection %q from state, the hub returned it masked\n", field, connectionReason(connection))
+				connection[field] = priorValue
+			}
+		}
+	}
+	return connections
+}
+
+// importAssetState imports an asset by its asset_id. The credentials of its
+// asset_connection blocks are masked by the hub and cannot be imported.
+func importAssetState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[WARN] Importing asset %s: the hub does not return the credentials of asset_connection blocks, they are imported as %q and must be set in the configuration\n", d.Id(), "*****")
+	return schema.ImportStatePassthroughContext(ctx, d, m)
+}
@@ -0,0 +1,111 @@
+package dsfhub
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPreserveMaskedSecrets(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestPreserveMaskedSecrets \n")
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"asset_connection": []interface{}{
+			map[string]interface{}{"reason": "default", "password": "my-password", "username": "admin"},
+		},
+	})
+
+	connections := []interface{}{
+		map[string]interface{}{"reason": "default", "password": "*****", "username": "admin"},
+		map[string]interface{}{"reason": "sonargateway", "password": "*****", "username": "gateway"},
+	}
+	preserved := preserveMaskedSecrets(d, connections)
+
+	if password := preserved[0].(map[string]interface{})["password"]; password != "my-password" {
+		t.Errorf("Should have kept the password of the default connection from state. Got: %v", password)
+	}
+	if password := preserved[1].(map[string]interface{})["password"]; password != "*****" {
+		t.Errorf("Should have kept the masked password of a connection not in state. Got: %v", password)
+	}
+}
+
+func TestSuppressMaskedSecretDiff(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestSuppressMaskedSecretDiff \n")
+
+	testCases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{"*****", "my-password", false},
+		{"*****", "", true},
+		{"my-password", "other-password", false},
+		{"", "my-password", false},
+	}
+	for _, testCase := range testCases {
+		if suppress := suppressMaskedSecretDiff("asset_connection.0.password", testCase.old, testCase.new, nil); suppress != testCase.suppress {
+			t.Errorf("Suppressing the diff from %q to %q should be %v. Got: %v", testCase.old, testCase.new, testCase.suppress, suppress)
+		}
+	}
+}
+
+func TestCredentialConnectionFieldsSensitive(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCredentialConnectionFieldsSensitive \n")
+
+	resources := map[string]*schema.Resource{
+		dsfCloudAccountResourceType:  resourceCloudAccount(),
+		dsfDataSourceResourceType:    resourceDSFDataSource(),
+		dsfLogAggregatorResourceType: resourceLogAggregator(),
+		dsfSecretManagerResourceType: resourceSecretManager(),
+	}
+	for resourceType, r := range resources {
+		connectionSchema := r.Schema["asset_connection"].Elem.(*schema.Resource).Schema
+		for _, field := range credentialConnectionFields {
+			fieldSchema, found := connectionSchema[field]
+			if !found {
+				continue
+			}
+			if !fieldSchema.Sensitive || fieldSchema.DiffSuppressFunc == nil {
+				t.Errorf("%s asset_connection.%s should be sensitive and suppress masked values", resourceType, field)
+			}
+		}
+	}
+}
+
+func TestCreateResourceMaskedSecret(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCreateResourceMaskedSecret \n")
+
+	// an imported connection whose password is only known masked
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"asset_connection": []interface{}{
+			map[string]interface{}{"reason": "default", "auth_mechanism": "password", "password": "*****", "username": "admin"},
+		},
+	})
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	connectionData := payload.Data.AssetData.Connections[0].ConnectionData
+	if connectionData.Password != "" || connectionData.Username != "admin" {
+		t.Errorf("Should not have sent the masked password. Got: %q, %q", connectionData.Password, connectionData.Username)
+	}
+}
+
+func TestPreserveMaskedSecretsRotated(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestPreserveMaskedSecretsRotated \n")
+
+	// after an import, the configured password replaces the mask in the state
+	state := map[string]interface{}{"reason": "default", "password": "*****", "username": "admin"}
+	r := resourceDSFDataSource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"asset_connection": []interface{}{state}})
+	d.Set("asset_connection", []interface{}{map[string]interface{}{"reason": "default", "password": "new-password", "username": "admin"}})
+
+	read := []interface{}{map[string]interface{}{"reason": "default", "password": "*****", "username": "admin"}}
+	if password := preserveMaskedSecrets(d, read)[0].(map[string]interface{})["password"]; password != "new-password" {
+		t.Errorf("Should have kept the configured password instead of the mask. Got: %v", password)
+	}
+}
@@ -0,0 +1,246 @@
+package dsfhub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Updates read the asset from the hub and overlay the fields managed by
+// Terraform over it, so that fields set outside of Terraform, e.g. in the DSF
+// UI, that the resource has no attribute for or that are not configured are
+// sent back unchanged instead of being cleared.
+
+// mergeCurrentAsset overlays payload, built from the configuration by
+// createResource, over the asset as currently read from the hub. A field of
+// payload that is not managed by Terraform and is empty takes its current
+// value. When update_conflict_check is enabled on the provider, an error is
+// returned if the asset changed since it was last read by Terraform, and when
+// workspace_id is set, if the asset is owned by another workspace.
+func mergeCurrentAsset(d *schema.ResourceData, m interface{}, resourceType string, assetId string, resourceSchema map[string]*schema.Schema, payload *ResourceWrapper) error {
+	client := m.(*Client)
+	current, err := readAsset(*client, resourceType, assetId)
+	if err != nil {
+		return fmt.Errorf("error reading asset %s before updating it: %s", assetId, err)
+	}
+
+	if client.config.UpdateConflictCheck {
+		if checksum, _ := d.Get("asset_checksum").(string); checksum != "" && checksum != assetChecksum(current.Data) {
+			return fmt.Errorf("asset %s was changed on the DSF Hub since it was last read by Terraform, run terraform plan again to review the update against its current state", assetId)
+		}
+	}
+
+	if err := assetOwnershipError(d, m, current.Data); err != nil {
+		return err
+	}
+
+	overlayCurrentAsset(d, resourceSchema, current.Data, payload, client.assetDefinitions())
+	return nil
+}
+
+// overlayCurrentAsset sets the fields of payload that are empty and not managed
+// by Terraform to their value in current, the asset as read from the hub
+func overlayCurrentAsset(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, current ResourceData, payload *ResourceWrapper, definitions *assetDefinitions) {
+	assetSchema := getSchema(definitions)
+	managed := func(id string) bool {
+		if _, found := resourceSchema[id]; !found {
+			return false
+		}
+		_, set := d.GetOk(id)
+		return set || d.HasChange(id)
+	}
+	overlayCurrentFields(reflect.ValueOf(&payload.Data).Elem(), reflect.ValueOf(current), assetSchema.Details, managed)
+	overlayCurrentFields(reflect.ValueOf(&payload.Data.AssetData).Elem(), reflect.ValueOf(current.AssetData), assetSchema.Details, managed)
+	// the extra fields set on payload, e.g. the ownership marker, are kept
+	oldExtra, newExtra := d.GetChange("extra_asset_data")
+	extra := mergeExtraData(current.AssetData.Extra, oldExtra, newExtra)
+	payload.Data.AssetData.Extra, _ = mergeJsonValues(extra, payload.Data.AssetData.Extra).(map[string]interface{})
+
+	currentConnections := map[string]AssetConnection{}
+	for _, connection := range current.AssetData.Connections {
+		currentConnections[connection.Reason] = connection
+	}
+	oldConnections, newConnections := assetConnectionsByReason(d.GetChange("asset_connection"))
+	connectionSchema := map[string]*schema.Schema{}
+	if attributeSchema, found := resourceSchema["asset_connection"]; found {
+		connectionSchema = attributeSchema.Elem.(*schema.Resource).Schema
+	}
+	for i := range payload.Data.AssetData.Connections {
+		connection := &payload.Data.AssetData.Connections[i]
+		currentConnection, found := currentConnections[connection.Reason]
+		if !found {
+			continue
+		}
+		if connection.AuthMechanism == "" {
+			connection.AuthMechanism = currentConnection.AuthMechanism
+		}
+		if connection.RoleName == "" {
+			connection.RoleName = currentConnection.RoleName
+		}
+		oldConnection, hadConnection := oldConnections[connection.Reason]
+		newConnection := newConnections[connection.Reason]
+		connectionManaged := func(id string) bool {
+			if _, found := connectionSchema[id]; !found {
+				return false
+			}
+			return !isEmptyAttributeValue(newConnection[id]) || hadConnection && !equalAttributeValues(oldConnection[id], newConnection[id])
+		}
+		overlayCurrentFields(reflect.ValueOf(&connection.ConnectionData).Elem(), reflect.ValueOf(currentConnection.ConnectionData), assetSchema.Connections, connectionManaged)
+		connection.ConnectionData.Extra = mergeExtraData(currentConnection.ConnectionData.Extra, oldConnection["extra_connection_data"], newConnection["extra_connection_data"])
+	}
+}
+
+// currentAssetPayload returns the payload updating an asset that is not
+// managed by the resource being applied to current, the asset as read from
+// the hub. Like the fields of updates that are not managed by Terraform, the
+// fields set by the hub and the credentials it masks are left out.
+func currentAssetPayload(current ResourceData, definitions *assetDefinitions) ResourceWrapper {
+	assetSchema := getSchema(definitions)
+	unmanaged := func(string) bool { return false }
+	payload := ResourceWrapper{}
+	overlayCurrentFields(reflect.ValueOf(&payload.Data).Elem(), reflect.ValueOf(current), assetSchema.Details, unmanaged)
+	overlayCurrentFields(reflect.ValueOf(&payload.Data.AssetData).Elem(), reflect.ValueOf(current.AssetData), assetSchema.Details, unmanaged)
+	payload.Data.AssetData.Extra = current.AssetData.Extra
+	for _, currentConnection := range current.AssetData.Connections {
+		connection := AssetConnection{
+			Reason:        currentConnection.Reason,
+			AuthMechanism: currentConnection.AuthMechanism,
+			RoleName:      currentConnection.RoleName,
+		}
+		overlayCurrentFields(reflect.ValueOf(&connection.ConnectionData).Elem(), reflect.ValueOf(currentConnection.ConnectionData), assetSchema.Connections, unmanaged)
+		connection.ConnectionData.Extra = currentConnection.ConnectionData.Extra
+		payload.Data.AssetData.Connections = append(payload.Data.AssetData.Connections, connection)
+	}
+	return payload
+}
+
+// updateAsset updates an asset of any resource type
+func updateAsset(client Client, resourceType string, assetId string, asset ResourceWrapper) (*ResourceWrapper, error) {
+	updateFuncs := map[string]func(string, ResourceWrapper) (*ResourceWrapper, error){
+		dsfDataSourceResourceType:    client.UpdateDSFDataSource,
+		dsfLogAggregatorResourceType: client.UpdateLogAggregator,
+		dsfCloudAccountResourceType:  client.UpdateCloudAccount,
+		dsfSecretManagerResourceType: client.UpdateSecretManager,
+	}
+
+	updateFn, ok := updateFuncs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("invalid resourceType: %v", resourceType)
+	}
+
+	log.Printf("[INFO] updating %s asset %v", resourceType, assetId)
+	return updateFn(assetId, asset)
+}
+
+// overlayCurrentFields sets each empty field of the struct payload that is
+// listed in fields and not managed by Terraform to its value in current.
+// Credentials masked by the hub are not sent back.
+func overlayCurrentFields(payload reflect.Value, current reflect.Value, fields map[string]SchemaField, managed func(string) bool) {
+	for i := 0; i < payload.NumField(); i++ {
+		name := payload.Type().Field(i).Name
+		schemaField, found := fields[name]
+		if !found || name == "AssetData" || name == "Connections" || managed(schemaField.ID) {
+			continue
+		}
+		field, currentField := payload.Field(i), current.Field(i)
+		if !field.IsZero() || currentField.IsZero() {
+			continue
+		}
+		if value, ok := currentField.Interface().(string); ok && isMaskedSecret(value) {
+			continue
+		}
+		log.Printf("[DEBUG] Keeping %s of the asset as read from the hub, it is not managed by Terraform\n", schemaField.ID)
+		field.Set(currentField)
+	}
+}
+
+// mergeExtraData returns the fields read from the hub that the provider does
+// not model with the extra data of the configuration merged over them. The
+// fields removed from the extra data since the last apply are removed.
+func mergeExtraData(current map[string]interface{}, oldValue interface{}, newValue interface{}) map[string]interface{} {
+	newExtra := extraDataValue(newValue)
+	merged := map[string]interface{}{}
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key := range extraDataValue(oldValue) {
+		if _, found := newExtra[key]; !found {
+			delete(merged, key)
+		}
+	}
+	for key, value := range newExtra {
+		merged[key] = mergeJsonValues(merged[key], value)
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+// assetConnectionsByReason returns the old and new asset_connection blocks of
+// d.GetChange by reason
+func assetConnectionsByReason(oldValue interface{}, newValue interface{}) (map[string]map[string]interface{}, map[string]map[string]interface{}) {
+	byReason := func(value interface{}) map[string]map[string]interface{} {
+		connections := map[string]map[string]interface{}{}
+		if list, ok := value.([]interface{}); ok {
+			for _, conn := range list {
+				if connection, ok := conn.(map[string]interface{}); ok {
+					connections[connectionReason(connection)] = connection
+				}
+			}
+		}
+		return connections
+	}
+	return byReason(oldValue), byReason(newValue)
+}
+
+// isEmptyAttributeValue returns true if an attribute value of an
+// asset_connection block is the zero value of its type
+func isEmptyAttributeValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case *schema.Set:
+		return v.Len() == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return reflect.ValueOf(value).IsZero()
+}
+
+// equalAttributeValues returns true if two attribute values of an
+// asset_connection block are equal
+func equalAttributeValues(a interface{}, b interface{}) bool {
+	setA, okA := a.(*schema.Set)
+	setB, okB := b.(*schema.Set)
+	if okA && okB {
+		return setA.Equal(setB)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// assetChecksum returns the checksum of an asset as read from the hub. Fields
+// the hub updates on its own, e.g. remoteSyncState, are left out so that only
+// changes to the asset itself are detected.
+func assetChecksum(asset ResourceData) string {
+	asset.ApplianceID = 0
+	asset.ApplianceType = ""
+	asset.AuditState = ""
+	asset.GatewayName = ""
+	asset.IsMonitored = false
+	asset.RemoteSyncState = ""
+	data, err := json.Marshal(asset)
+	if err != nil {
+		log.Printf("[WARN] Marshalling asset %s to compute its checksum: %s\n", asset.ID, err)
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
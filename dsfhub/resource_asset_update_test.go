@@ -0,0 +1,166 @@
+package dsfhub
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testCurrentDataSource is a MYSQL data source as read from the hub, with
+// fields set outside of Terraform
+const testCurrentDataSource = `{"data": {"id": "my-mysql-db", "serverType": "MYSQL", "gatewayId": "my-gateway", "remoteSyncState": "SYNCED", "assetData": {
+	"admin_email": "old@email.com",
+	"asset_id": "my-mysql-db",
+	"location": "my-datacenter",
+	"hub_field": "set in the UI",
+	"connections": [{"reason": "default", "connectionData": {"auth_mechanism": "password", "username": "admin", "password": "*****", "database_name": "my-db", "hub_option": true}}]
+}}}`
+
+// testUpdateServer returns a hub returning testCurrentDataSource
+func testUpdateServer(t *testing.T) (*httptest.Server, *Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.Path != baseAPIPrefix+endpointDsfDataSource+"/my-mysql-db" {
+			t.Errorf("Should not have called %s %s", req.Method, req.URL.Path)
+		}
+		rw.Write([]byte(testCurrentDataSource))
+	}))
+	client := &Client{config: &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}, httpClient: &http.Client{}}
+	return server, client
+}
+
+func TestMergeCurrentAsset(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestMergeCurrentAsset \n")
+
+	server, client := testUpdateServer(t)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, testExtraDataConfig(`{"new_field": "new"}`, ""))
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	if err := mergeCurrentAsset(d, client, dsfDataSourceResourceType, "my-mysql-db", resourceDSFDataSource().Schema, &payload); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+
+	assetData := payload.Data.AssetData
+	if assetData.AdminEmail != testAdminEmail {
+		t.Errorf("Should have sent admin_email from the configuration. Got: %s", assetData.AdminEmail)
+	}
+	if assetData.Location != "my-datacenter" {
+		t.Errorf("Should have kept location, which is not configured. Got: %s", assetData.Location)
+	}
+	if !reflect.DeepEqual(assetData.Extra, map[string]interface{}{"hub_field": "set in the UI", "new_field": "new"}) {
+		t.Errorf("Should have kept hub_field and added new_field. Got: %v", assetData.Extra)
+	}
+	if payload.Data.RemoteSyncState != "" {
+		t.Errorf("Should not have sent remoteSyncState, which is set by the hub. Got: %s", payload.Data.RemoteSyncState)
+	}
+
+	connectionData := assetData.Connections[0].ConnectionData
+	if connectionData.Password != "my-password" || connectionData.DatabaseName != "my-db" {
+		t.Errorf("Should have sent password from the configuration and kept database_name. Got: %s, %s", connectionData.Password, connectionData.DatabaseName)
+	}
+	if !reflect.DeepEqual(connectionData.Extra, map[string]interface{}{"hub_option": true}) {
+		t.Errorf("Should have kept hub_option. Got: %v", connectionData.Extra)
+	}
+}
+
+func TestMergeCurrentAssetMaskedSecret(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestMergeCurrentAssetMaskedSecret \n")
+
+	server, client := testUpdateServer(t)
+	defer server.Close()
+
+	config := testExtraDataConfig("", "")
+	delete(config["asset_connection"].([]interface{})[0].(map[string]interface{}), "password")
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, config)
+	payload := ResourceWrapper{}
+	createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+	if err := mergeCurrentAsset(d, client, dsfDataSourceResourceType, "my-mysql-db", resourceDSFDataSource().Schema, &payload); err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if password := payload.Data.AssetData.Connections[0].ConnectionData.Password; password != "" {
+		t.Errorf("Should not have sent back the password masked by the hub. Got: %s", password)
+	}
+}
+
+func TestMergeCurrentAssetConflictCheck(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestMergeCurrentAssetConflictCheck \n")
+
+	server, client := testUpdateServer(t)
+	defer server.Close()
+	client.config.UpdateConflictCheck = true
+
+	current, err := client.ReadDSFDataSource("my-mysql-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		checksum string
+		expected string
+	}{
+		{"", ""},
+		{assetChecksum(current.Data), ""},
+		{"stale", "asset my-mysql-db was changed on the DSF Hub since it was last read by Terraform"},
+	} {
+		d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, testExtraDataConfig("", ""))
+		d.Set("asset_checksum", tc.checksum)
+		payload := ResourceWrapper{}
+		createResource(&payload, "MYSQL", d, embeddedAssetDefinitions)
+		err := mergeCurrentAsset(d, client, dsfDataSourceResourceType, "my-mysql-db", resourceDSFDataSource().Schema, &payload)
+		switch {
+		case tc.expected == "" && err != nil:
+			t.Errorf("Checksum %q should not have returned an error. Got: %s", tc.checksum, err)
+		case tc.expected != "" && (err == nil || !strings.HasPrefix(err.Error(), tc.expected)):
+			t.Errorf("Checksum %q should have returned %q. Got: %v", tc.checksum, tc.expected, err)
+		}
+	}
+}
+
+func TestAssetChecksumIgnoresHubState(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetChecksumIgnoresHubState \n")
+
+	asset := ResourceData{ID: "my-mysql-db", ServerType: "MYSQL", RemoteSyncState: "SYNCED", AuditState: "YES"}
+	synced := assetChecksum(asset)
+
+	asset.RemoteSyncState = "SYNCING"
+	asset.AuditState = "NO"
+	if assetChecksum(asset) != synced {
+		t.Errorf("Should not have changed the checksum when the hub updates remoteSyncState and auditState")
+	}
+	asset.AssetData.Location = "my-datacenter"
+	if assetChecksum(asset) == synced {
+		t.Errorf("Should have changed the checksum when the asset changes")
+	}
+}
+
+func TestMergeExtraData(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestMergeExtraData \n")
+
+	current := map[string]interface{}{
+		"hub_field":     "set in the UI",
+		"removed_field": "sent by a previous apply",
+		"nested":        map[string]interface{}{"hub": "x"},
+	}
+	merged := mergeExtraData(current, `{"removed_field": "sent by a previous apply", "nested": {"a": 1}}`, `{"nested": {"b": 2}}`)
+	expected := map[string]interface{}{
+		"hub_field": "set in the UI",
+		"nested":    map[string]interface{}{"hub": "x", "b": json.Number("2")},
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("Should have removed removed_field and merged nested. Got: %v", merged)
+	}
+	if mergeExtraData(nil, "", "") != nil {
+		t.Errorf("Should not have sent extra data when there is none")
+	}
+}
@@ -0,0 +1,143 @@
+package dsfhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// writeOnlyCredentialField returns the name of the write-only variant of a
+// credential
+func writeOnlyCredentialField(field string) string {
+	return field + "_wo"
+}
+
+// writeOnlyCredentialVersionField returns the name of the attribute whose
+// changes trigger the rotation of a write-only credential
+func writeOnlyCredentialVersionField(field string) string {
+	return field + "_wo_version"
+}
+
+// addWriteOnlyCredentialSchemas adds the write-only variant of each credential
+// of the asset_connection block of r, with its _wo_version companion
+func addWriteOnlyCredentialSchemas(r *schema.Resource) {
+	connectionSchema := r.Schema["asset_connection"].Elem.(*schema.Resource).Schema
+	for _, field := range writeOnlyCredentialFields {
+		if _, found := connectionSchema[field]; !found {
+			continue
+		}
+		connectionSchema[writeOnlyCredentialField(field)] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("Write-only variant of %s, which is sent to the DSF Hub but never stored in the Terraform state. Requires Terraform 1.11 or later.", field),
+			Required:    false,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		}
+		connectionSchema[writeOnlyCredentialVersionField(field)] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: fmt.Sprintf("Version of %s, change it to send a new value of %s to the DSF Hub.", writeOnlyCredentialField(field), writeOnlyCredentialField(field)),
+			Required:    false,
+			Optional:    true,
+		}
+	}
+
+	r.ValidateRawResourceConfigFuncs = append(r.ValidateRawResourceConfigFuncs, validateWriteOnlyCredentials)
+	for _, field := range writeOnlyCredentialFields {
+		if _, found := connectionSchema[field]; !found {
+			continue
+		}
+		anyConnection := cty.GetAttrPath("asset_connection").Index(cty.UnknownVal(cty.Number))
+		r.ValidateRawResourceConfigFuncs = append(r.ValidateRawResourceConfigFuncs, validation.PreferWriteOnlyAttribute(anyConnection.GetAttr(field), anyConnection.GetAttr(writeOnlyCredentialField(field))))
+	}
+}
+
+// writeOnlyCredentialSet returns true if the credential field of connection is
+// set with its write-only variant. The value of a write-only credential may be
+// hidden from the plan, in which case its _wo_version is used instead.
+func writeOnlyCredentialSet(connection map[string]interface{}, field string) bool {
+	if value, ok := connection[writeOnlyCredentialField(field)].(string); ok && value != "" {
+		return true
+	}
+	version, ok := connection[writeOnlyCredentialVersionField(field)].(int)
+	return ok && version != 0
+}
+
+// validateWriteOnlyCredentials fails the validation of an asset_connection
+// block which sets both a credential and its write-only variant
+func validateWriteOnlyCredentials(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if !req.RawConfig.IsKnown() || req.RawConfig.IsNull() || !req.RawConfig.Type().HasAttribute("asset_connection") {
+		return
+	}
+	connections := req.RawConfig.GetAttr("asset_connection")
+	if !connections.IsKnown() || connections.IsNull() {
+		return
+	}
+
+	for it := connections.ElementIterator(); it.Next(); {
+		index, connection := it.Element()
+		if !connection.IsKnown() || connection.IsNull() {
+			continue
+		}
+		for _, field := range writeOnlyCredentialFields {
+			writeOnlyField := writeOnlyCredentialField(field)
+			if !connection.Type().HasAttribute(field) || !connection.Type().HasAttribute(writeOnlyField) {
+				continue
+			}
+			if connection.GetAttr(field).IsNull() || connection.GetAttr(writeOnlyField).IsNull() {
+				continue
+			}
+			resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Conflicting asset_connection credentials",
+				Detail:        fmt.Sprintf("Only one of %s and %s can be set in an asset_connection block.", field, writeOnlyField),
+				AttributePath: cty.GetAttrPath("asset_connection").Index(index).GetAttr(writeOnlyField),
+			})
+		}
+	}
+}
+
+// applyWriteOnlyCredentials sets the credentials of the asset_connection block
+// at index i from their write-only variants, which are only available in the
+// configuration
+func applyWriteOnlyCredentials(d *schema.ResourceData, i int, connection map[string]interface{}) {
+	for _, field := range writeOnlyCredentialFields {
+		path := cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr(writeOnlyCredentialField(field))
+		value, diags := d.GetRawConfigAt(path)
+		if diags.HasError() || !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+			continue
+		}
+		log.Printf("[DEBUG] Setting asset_connection.%s from %s\n", field, writeOnlyCredentialField(field))
+		connection[field] = value.AsString()
+	}
+}
+
+// preserveWriteOnlyCredentialVersions copies the _wo_version attributes of the
+// connections in the state to the asset_connection blocks read from the hub,
+// which knows nothing about them
+func preserveWriteOnlyCredentialVersions(d *schema.ResourceData, connections []interface{}) []interface{} {
+	priorConnections := priorAssetConnections(d)
+
+	for _, conn := range connections {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		priorConnection, found := priorConnections[connectionReason(connection)]
+		if !found {
+			continue
+		}
+		for _, field := range writeOnlyCredentialFields {
+			versionField := writeOnlyCredentialVersionField(field)
+			if version, ok := priorConnection[versionField]; ok {
+				connection[versionField] = version
+			}
+		}
+	}
+	return connections
+}
@@ -0,0 +1,102 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testWriteOnlyRawConfig is the configuration of a data source connecting with
+// a write-only password
+const testWriteOnlyRawConfig = `{
+	"asset_id": "my-mysql-db",
+	"server_type": "MYSQL",
+	"asset_connection": [
+		{
+			"auth_mechanism": "password",
+			"password_wo": "my-password",
+			"password_wo_version": 1,
+			"reason": "default",
+			"username": "admin"
+		}
+	]
+}`
+
+func TestApplyWriteOnlyCredentials(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestApplyWriteOnlyCredentials \n")
+
+	r := resourceDSFDataSource()
+	rawConfig, err := ctyjson.Unmarshal([]byte(testWriteOnlyRawConfig), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("Should have parsed the configuration: %s", err)
+	}
+	d := r.Data(&terraform.InstanceState{
+		ID: "my-mysql-db",
+		Attributes: map[string]string{
+			"asset_connection.#":                     "1",
+			"asset_connection.0.auth_mechanism":      "password",
+			"asset_connection.0.password_wo_version": "1",
+			"asset_connection.0.reason":              "default",
+			"asset_connection.0.username":            "admin",
+		},
+		RawConfig: rawConfig,
+	})
+
+	dsfDataSource := ResourceWrapper{}
+	createResource(&dsfDataSource, "MYSQL", d, embeddedAssetDefinitions)
+	if len(dsfDataSource.Data.AssetData.Connections) != 1 {
+		t.Fatalf("Should have created one connection. Got: %v", dsfDataSource.Data.AssetData.Connections)
+	}
+	if password := dsfDataSource.Data.AssetData.Connections[0].ConnectionData.Password; password != "my-password" {
+		t.Errorf("Should have sent the password from password_wo. Got: %q", password)
+	}
+	if d.Get("asset_connection.0.password").(string) != "" {
+		t.Errorf("Should not have stored the password. Got: %q", d.Get("asset_connection.0.password"))
+	}
+}
+
+func TestValidateWriteOnlyCredentials(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestValidateWriteOnlyCredentials \n")
+
+	r := resourceDSFDataSource()
+	configs := map[string]bool{
+		`{"asset_connection":[{"reason":"default","password_wo":"my-password"}]}`:                          false,
+		`{"asset_connection":[{"reason":"default","password":"my-password"}]}`:                             false,
+		`{"asset_connection":[{"reason":"default","password":"my-password","password_wo":"my-password"}]}`: true,
+	}
+	for config, conflict := range configs {
+		rawConfig, err := ctyjson.Unmarshal([]byte(config), r.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			t.Fatalf("Should have parsed the configuration: %s", err)
+		}
+		resp := &schema.ValidateResourceConfigFuncResponse{}
+		validateWriteOnlyCredentials(context.Background(), schema.ValidateResourceConfigFuncRequest{WriteOnlyAttributesAllowed: true, RawConfig: rawConfig}, resp)
+		if resp.Diagnostics.HasError() != conflict {
+			t.Errorf("Validating %s should have failed: %v. Got: %v", config, conflict, resp.Diagnostics)
+		}
+	}
+}
+
+func TestPreserveWriteOnlyCredentialVersions(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestPreserveWriteOnlyCredentialVersions \n")
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"asset_connection": []interface{}{
+			map[string]interface{}{"reason": "default", "password_wo_version": 3},
+		},
+	})
+
+	connections := preserveWriteOnlyCredentialVersions(d, []interface{}{
+		map[string]interface{}{"reason": "default", "password": "*****"},
+	})
+	if version := connections[0].(map[string]interface{})["password_wo_version"]; version != 3 {
+		t.Errorf("Should have kept password_wo_version from state. Got: %v", version)
+	}
+}
@@ -0,0 +1,164 @@
+package dsfhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// auditCollectionAssetTypes maps the asset_type argument of the
+// dsfhub_audit_collection resource to the resource type of the asset it manages
+var auditCollectionAssetTypes = map[string]string{
+	"data_source":    dsfDataSourceResourceType,
+	"log_aggregator": dsfLogAggregatorResourceType,
+}
+
+func resourceAuditCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuditCollectionCreateContext,
+		ReadContext:   resourceAuditCollectionReadContext,
+		DeleteContext: resourceAuditCollectionDeleteContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAuditCollectionImportStateContext,
+		},
+		Description: "Enables audit collection for an existing data source or log aggregator asset.",
+
+		Schema: map[string]*schema.Schema{
+			"asset_id": {
+				Type:        schema.TypeString,
+				Description: "The asset_id of the data source or log aggregator to collect audit from.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"asset_type": {
+				Type:         schema.TypeString,
+				Description:  "The kind of asset referenced by asset_id. Available values: \"data_source\", \"log_aggregator\". Default: \"data_source\"",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "data_source",
+				ValidateFunc: validation.StringInSlice([]string{"data_source", "log_aggregator"}, false),
+			},
+			"audit_pull_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the hub reports audit collection as enabled for the asset.",
+				Computed:    true,
+			},
+			"audit_state": {
+				Type:        schema.TypeString,
+				Description: "The audit state of the asset as reported by the hub.",
+				Computed:    true,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Description: "The jsonarUid unique identifier of the agentless gateway collecting audit for the asset.",
+				Computed:    true,
+			},
+			"gateway_service": {
+				Type:        schema.TypeString,
+				Description: "The name of the gateway pull service used to retrieve logs for the asset.",
+				Computed:    true,
+			},
+			"remote_sync_state": {
+				Type:        schema.TypeString,
+				Description: "The sync state of the asset on the agentless gateways. Possible values: \"SYNCED\", \"NOT_SYNCED\", \"UNKNOWN\"",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAuditCollectionCreateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	assetId := d.Get("asset_id").(string)
+	resourceType := auditCollectionAssetTypes[d.Get("asset_type").(string)]
+
+	// make sure the asset exists before changing its audit state
+	isAuditPullEnabled, err := checkAuditState(ctx, m, assetId, resourceType)
+	if err != nil {
+		log.Printf("[ERROR] Reading %s asset %s before enabling audit collection | err: %s\n", resourceType, assetId, err)
+		return diag.FromErr(err)
+	}
+
+	if isAuditPullEnabled {
+		log.Printf("[INFO] Audit collection is already enabled for assetId: %s\n", assetId)
+	} else {
+		log.Printf("[INFO] Enabling audit collection for %s asset %s\n", resourceType, assetId)
+		// waits for the asset to be synced and verifies its audit state
+		err = connectGateway(ctx, m, assetId, resourceType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(assetId)
+
+	// Set the rest of the state from the resource read
+	return resourceAuditCollectionReadContext(ctx, d, m)
+}
+
+func resourceAuditCollectionReadContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	assetId := d.Id()
+	resourceType := auditCollectionAssetTypes[d.Get("asset_type").(string)]
+
+	log.Printf("[INFO] Reading audit collection for %s asset %s\n", resourceType, assetId)
+	result, err := readAsset(*client, resourceType, assetId)
+	if err != nil {
+		log.Printf("[ERROR] Reading audit collection for assetId: %s | err: %s\n", assetId, err)
+		return diag.FromErr(err)
+	}
+
+	// audit collection has been disabled outside of terraform, let the next plan re-enable it
+	if !result.Data.AssetData.AuditPullEnabled {
+		log.Printf("[WARN] Audit collection is no longer enabled for assetId: %s, removing from state\n", assetId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("asset_id", result.Data.AssetData.AssetID)
+	d.Set("audit_pull_enabled", result.Data.AssetData.AuditPullEnabled)
+	d.Set("audit_state", result.Data.AuditState)
+	d.Set("gateway_id", result.Data.GatewayID)
+	d.Set("gateway_service", result.Data.AssetData.GatewayService)
+	d.Set("remote_sync_state", result.Data.RemoteSyncState)
+
+	log.Printf("[INFO] Finished reading audit collection for assetId: %s\n", assetId)
+
+	return nil
+}
+
+func resourceAuditCollectionDeleteContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	assetId := d.Id()
+	resourceType := auditCollectionAssetTypes[d.Get("asset_type").(string)]
+
+	log.Printf("[INFO] Disabling audit collection for %s asset %s\n", resourceType, assetId)
+	// waits for the asset to be synced and verifies its audit state
+	err := disconnectGateway(ctx, m, assetId, resourceType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceAuditCollectionImportStateContext imports audit collection by
+// asset_id, looking the asset up as a data source first and then as a log
+// aggregator to determine its asset_type
+func resourceAuditCollectionImportStateContext(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*Client)
+	assetId := d.Id()
+
+	for _, assetType := range []string{"data_source", "log_aggregator"} {
+		if _, err := readAsset(*client, auditCollectionAssetTypes[assetType], assetId); err == nil {
+			log.Printf("[INFO] Importing audit collection for %s asset %s\n", assetType, assetId)
+			d.Set("asset_id", assetId)
+			d.Set("asset_type", assetType)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find a data source or log aggregator with asset_id: %s", assetId)
+}
@@ -0,0 +1,65 @@
+package dsfhub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDSFAuditCollection_AwsLogGroup(t *testing.T) {
+	gatewayId := checkGatewayId(t)
+
+	const (
+		assetId            = testAwsLogGroupPrefix + "/aws/rds/instance/my-audit-collection-db/audit:*"
+		resourceName       = "my-audit-collection-db-log-group"
+		parentAssetId      = "arn:aws:rds:us-east-2:123456789012:db:my-audit-collection-db"
+		parentResourceName = "my-audit-collection-db"
+	)
+
+	resourceTypeAndName := fmt.Sprintf("%s.%s", dsfAuditCollectionResourceType, resourceName)
+	logGroupResourceTypeAndName := fmt.Sprintf("%s.%s", dsfLogAggregatorResourceType, resourceName)
+	parentResourceTypeAndName := fmt.Sprintf("%s.%s", dsfDataSourceResourceType, parentResourceName)
+
+	assetsConfig := ConfigCompose(
+		testAccDSFDataSourceConfig_AwsRdsOracle(parentResourceName, gatewayId, parentAssetId, "LOG_GROUP", ""),
+		testAccDSFAuditCollectionConfig_AwsLogGroup(resourceName, gatewayId, assetId, parentResourceTypeAndName+".asset_id"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Enable audit collection on the log aggregator
+			{
+				Config: ConfigCompose(
+					assetsConfig,
+					testAccDSFAuditCollectionConfig_Basic(resourceName, logGroupResourceTypeAndName+".asset_id", "log_aggregator"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceTypeAndName, "audit_pull_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceTypeAndName, "gateway_service", "gateway-aws@oracle-rds.service"),
+				),
+			},
+			// The asset reflects the audit state without a diff
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(logGroupResourceTypeAndName, "audit_pull_enabled", "true"),
+				),
+			},
+			// validate import
+			validateImportStep(resourceTypeAndName),
+			// Disable audit collection by removing the resource
+			{
+				Config: assetsConfig,
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(logGroupResourceTypeAndName, "audit_pull_enabled", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package dsfhub
+
+import "fmt"
+
+// Output a terraform config for an AWS LOG GROUP log aggregator resource whose
+// audit collection is managed by a separate dsfhub_audit_collection resource.
+func testAccDSFAuditCollectionConfig_AwsLogGroup(resourceName string, gatewayId string, assetId string, parentAssetId string) string {
+	// handle reference to other assets
+	parentAssetIdVal := testAccParseResourceAttributeReference(parentAssetId)
+
+	return fmt.Sprintf(`
+resource "%[1]s" "%[2]s" {
+  server_type        = "AWS LOG GROUP"
+
+  admin_email        = "%[3]s"
+  arn                = "%[5]s"
+  asset_display_name = "%[5]s"
+  asset_id           = "%[5]s"
+  audit_type         = "LOG_GROUP"
+  gateway_id         = "%[4]s"
+  parent_asset_id    = %[6]s
+
+  %[7]s
+}`, dsfLogAggregatorResourceType, resourceName, testAdminEmail, gatewayId, assetId, parentAssetIdVal, awsCommonConnectionDefault)
+}
+
+// Output a terraform config for an audit collection resource.
+func testAccDSFAuditCollectionConfig_Basic(resourceName string, assetId string, assetType string) string {
+	// handle reference to other assets
+	assetIdVal := testAccParseResourceAttributeReference(assetId)
+
+	return fmt.Sprintf(`
+resource "%[1]s" "%[2]s" {
+  asset_id   = %[3]s
+  asset_type = "%[4]s"
+}`, dsfAuditCollectionResourceType, resourceName, assetIdVal, assetType)
+}
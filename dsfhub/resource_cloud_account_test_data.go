@@ -10,7 +10,7 @@ const awsConnectionDefault = `
   }
 `
 
-var awsConnectionKey = fmt.Sprintf(`
+const awsConnectionKey = `
   asset_connection {
     access_id       = "my-access-id"
     auth_mechanism  = "key"
@@ -18,9 +18,7 @@ var awsConnectionKey = fmt.Sprintf(`
     region          = "us-east-1"
     secret_key      = "my-secret-key"
   }
-
-  %[1]s
-`, ignoreAssetConnectionChangesBlock())
+`
 
 const awsConnectionProfile = `
   asset_connection {
@@ -62,7 +60,7 @@ resource "%[1]s" "%[2]s" {
 }`, dsfCloudAccountResourceType, resourceName, testAdminEmail, assetId, gatewayId, assetConnectionBlock)
 }
 
-var azureConnectionClientSecret = fmt.Sprintf(`
+const azureConnectionClientSecret = `
   asset_connection {
     auth_mechanism  = "client_secret"
     application_id  = "12345678-1234-1234-1234-123456789012" 
@@ -71,9 +69,7 @@ var azureConnectionClientSecret = fmt.Sprintf(`
     reason          = "default"
     subscription_id = "87654321-4321-4321-4321-210987654321"
   }
-
-  %[1]s
-`, ignoreAssetConnectionChangesBlock())
+`
 
 const azureConnectionAuthFile = `
   asset_connection {
@@ -0,0 +1,308 @@
+package dsfhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudIdentity holds the fields derived from the cloud identifier of an
+// asset, and the identifier attribute they were derived from
+type cloudIdentity struct {
+	Source     string
+	Identifier string
+	Fields     map[string]string
+}
+
+// awsServiceEndpoints holds the endpoint prefix of the AWS server types that
+// are reached on the regional endpoint of their service, e.g.
+// dynamodb.us-east-1.amazonaws.com
+var awsServiceEndpoints = map[string]string{
+	"AWS ATHENA":         "athena",
+	"AWS DYNAMODB":       "dynamodb",
+	"AWS GLUE":           "glue",
+	"AWS KINESIS":        "kinesis",
+	"AWS LAKE FORMATION": "lakeformation",
+	"AWS LOG GROUP":      "logs",
+}
+
+// azureHostSuffixes holds the Azure resource type and the DNS suffix of the
+// host name of the Azure server types whose host name is the name of the
+// resource followed by the suffix, e.g. my-server.database.windows.net
+var azureHostSuffixes = map[string]struct {
+	ResourceType string
+	Suffix       string
+}{
+	"AZURE COSMOSDB":            {"Microsoft.DocumentDB/databaseAccounts", "documents.azure.com"},
+	"AZURE COSMOSDB MONGO":      {"Microsoft.DocumentDB/databaseAccounts", "mongo.cosmos.azure.com"},
+	"AZURE COSMOSDB TABLE":      {"Microsoft.DocumentDB/databaseAccounts", "table.cosmos.azure.com"},
+	"AZURE EVENTHUB":            {"Microsoft.EventHub/namespaces", "servicebus.windows.net"},
+	"AZURE MARIADB":             {"Microsoft.DBforMariaDB/servers", "mariadb.database.azure.com"},
+	"AZURE MS SQL SERVER":       {"Microsoft.Sql/servers", "database.windows.net"},
+	"AZURE MYSQL":               {"Microsoft.DBforMySQL/servers", "mysql.database.azure.com"},
+	"AZURE MYSQL FLEXIBLE":      {"Microsoft.DBforMySQL/flexibleServers", "mysql.database.azure.com"},
+	"AZURE POSTGRESQL":          {"Microsoft.DBforPostgreSQL/servers", "postgres.database.azure.com"},
+	"AZURE POSTGRESQL FLEXIBLE": {"Microsoft.DBforPostgreSQL/flexibleServers", "postgres.database.azure.com"},
+}
+
+// gcpServiceHostNames holds the host name of the GCP server types that are
+// reached on the global endpoint of their service
+var gcpServiceHostNames = map[string]string{
+	"GCP BIGQUERY":  "bigquery.googleapis.com",
+	"GCP BIGTABLE":  "bigtable.googleapis.com",
+	"GCP FIRESTORE": "firestore.googleapis.com",
+	"GCP PUBSUB":    "pubsub.googleapis.com",
+	"GCP SPANNER":   "spanner.googleapis.com",
+}
+
+// parseAwsArn returns the fields derived from an ARN, in the format
+// arn:partition:service:region:account-id:resource
+func parseAwsArn(serverType string, arn string) (map[string]string, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[1] == "" || parts[2] == "" || parts[5] == "" {
+		return nil, fmt.Errorf("invalid ARN %q, expected format arn:partition:service:region:account-id:resource", arn)
+	}
+
+	fields := map[string]string{}
+	// global services such as IAM and S3 have no region
+	if parts[3] == "" {
+		return fields, nil
+	}
+	fields["region"] = parts[3]
+	if endpoint, found := awsServiceEndpoints[serverType]; found {
+		dnsSuffix := "amazonaws.com"
+		if parts[1] == "aws-cn" {
+			dnsSuffix = "amazonaws.com.cn"
+		}
+		fields["server_host_name"] = fmt.Sprintf("%s.%s.%s", endpoint, parts[3], dnsSuffix)
+	}
+	return fields, nil
+}
+
+// parseAzureResourceId returns the fields derived from an Azure resource ID,
+// in the format /subscriptions/<subscription id>/resourceGroups/<resource group>/providers/...
+// Azure resource IDs do not hold the location of the resource.
+func parseAzureResourceId(serverType string, resourceId string) (map[string]string, error) {
+	segments := strings.Split(strings.TrimPrefix(resourceId, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") || segments[1] == "" {
+		return nil, fmt.Errorf("invalid Azure resource ID %q, expected format /subscriptions/<subscription id>/resourceGroups/<resource group>/providers/...", resourceId)
+	}
+
+	fields := map[string]string{"subscription_id": segments[1]}
+	// the host name is derived from the name of the top-level resource, e.g.
+	// the namespace of an event hub in .../providers/Microsoft.EventHub/namespaces/<namespace>/eventhubs/<event hub>
+	host, found := azureHostSuffixes[serverType]
+	for i := 2; found && i+3 < len(segments); i++ {
+		if !strings.EqualFold(segments[i], "providers") {
+			continue
+		}
+		if strings.EqualFold(segments[i+1]+"/"+segments[i+2], host.ResourceType) && segments[i+3] != "" {
+			fields["server_host_name"] = strings.ToLower(segments[i+3]) + "." + host.Suffix
+		}
+		break
+	}
+	return fields, nil
+}
+
+// parseGcpResourceName returns the fields derived from a GCP resource name,
+// such as projects/<project>/locations/<location>/... optionally prefixed
+// with the service name, e.g. //bigquery.googleapis.com/projects/...
+func parseGcpResourceName(serverType string, resourceName string) (map[string]string, error) {
+	name := resourceName
+	if strings.HasPrefix(name, "//") {
+		parts := strings.SplitN(strings.TrimPrefix(name, "//"), "/", 2)
+		name = parts[len(parts)-1]
+	}
+	segments := strings.Split(name, "/")
+
+	fields := map[string]string{}
+	collectionFields := map[string]string{
+		"locations": "location",
+		"projects":  "project",
+		"regions":   "region",
+	}
+	for i := 0; i+1 < len(segments); i += 2 {
+		field, found := collectionFields[segments[i]]
+		if !found {
+			continue
+		}
+		if segments[i+1] == "" {
+			return nil, fmt.Errorf("invalid GCP resource name %q, %s must not be empty", resourceName, segments[i])
+		}
+		if _, set := fields[field]; !set {
+			fields[field] = segments[i+1]
+		}
+	}
+	if _, found := fields["project"]; !found {
+		return nil, fmt.Errorf("invalid GCP resource name %q, expected format projects/<project>/...", resourceName)
+	}
+	if hostName, found := gcpServiceHostNames[serverType]; found {
+		fields["server_host_name"] = hostName
+	}
+	return fields, nil
+}
+
+// parseCloudIdentity returns the fields derived from the identifier of an
+// asset hosted on a cloud: the arn (or an asset_id in the ARN format) for AWS,
+// an asset_id in the Azure resource ID format for AZURE, and an asset_id in
+// the GCP resource name format for GCP. It returns nil if the asset has no
+// such identifier.
+func parseCloudIdentity(serverType string, assetId string, arn string) (*cloudIdentity, error) {
+	var identity *cloudIdentity
+	var err error
+	switch serverTypeCloud(serverType) {
+	case "AWS":
+		if arn != "" {
+			identity = &cloudIdentity{Source: "arn", Identifier: arn}
+			identity.Fields, err = parseAwsArn(serverType, arn)
+		} else if strings.HasPrefix(assetId, "arn:") {
+			identity = &cloudIdentity{Source: "asset_id", Identifier: assetId}
+			identity.Fields, err = parseAwsArn(serverType, assetId)
+		}
+	case "AZURE":
+		if strings.HasPrefix(strings.ToLower(assetId), "/subscriptions/") {
+			identity = &cloudIdentity{Source: "asset_id", Identifier: assetId}
+			identity.Fields, err = parseAzureResourceId(serverType, assetId)
+		}
+	case "GCP":
+		if strings.HasPrefix(assetId, "projects/") || (strings.HasPrefix(assetId, "//") && strings.Contains(assetId, "/projects/")) {
+			identity = &cloudIdentity{Source: "asset_id", Identifier: assetId}
+			identity.Fields, err = parseGcpResourceName(serverType, assetId)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// configuredString returns the value of a string attribute in the resource
+// configuration and whether it is set. Unknown values are reported through
+// known being false.
+func configuredString(d *schema.ResourceDiff, field string) (value string, set bool, known bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(field) {
+		// no configuration is available when the diff is computed outside of
+		// terraform, fall back on the planned value
+		if !d.NewValueKnown(field) {
+			return "", false, true
+		}
+		value = d.Get(field).(string)
+		return value, value != "", true
+	}
+
+	configValue := rawConfig.GetAttr(field)
+	if !configValue.IsKnown() {
+		return "", false, false
+	}
+	if configValue.IsNull() {
+		return "", false, true
+	}
+	return configValue.AsString(), configValue.AsString() != "", true
+}
+
+// cloudIdentityFieldDerivable returns whether a field that is not set in the
+// configuration may be derived from the cloud identifier of the asset at plan
+// time. Fields are left to the plan while the server type, or the identifier
+// of a cloud asset, is not known, or is not valid for the plan to report it.
+func cloudIdentityFieldDerivable(config resourceFieldReader, field string) bool {
+	if config.Get("server_type") == unknownVariableValue {
+		return true
+	}
+	serverType, _ := config.Get("server_type").(string)
+	if serverTypeCloud(serverType) == "" {
+		return false
+	}
+	for _, name := range []string{"asset_id", "arn"} {
+		if config.Get(name) == unknownVariableValue {
+			return true
+		}
+	}
+	assetId, _ := config.Get("asset_id").(string)
+	arn, _ := config.Get("arn").(string)
+	identity, err := parseCloudIdentity(serverType, assetId, arn)
+	if err != nil {
+		return true
+	}
+	if identity == nil {
+		return false
+	}
+	_, found := identity.Fields[field]
+	return found
+}
+
+// overridableCloudIdentityFields lists the derived fields that may be set to
+// another value than the one derived from the identifier: a server can be
+// reached on another host name than the endpoint of its service, e.g. a
+// private endpoint
+var overridableCloudIdentityFields = map[string]bool{
+	"server_host_name": true,
+}
+
+// cloudIdentityCustomizeDiff fills the given fields from the cloud identifier
+// of the asset when they are not set, and fails the plan when a field is set
+// to a value that contradicts the identifier, unless the field is
+// overridable
+func cloudIdentityCustomizeDiff(fields ...string) schema.CustomizeDiffFunc {
+	sort.Strings(fields)
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		arn, _, arnKnown := configuredString(d, "arn")
+		if !d.NewValueKnown("server_type") || !d.NewValueKnown("asset_id") || !arnKnown {
+			// the fields that are not set are derived once the identifier is
+			// known, which only happens for assets hosted on a cloud
+			if d.NewValueKnown("server_type") && serverTypeCloud(d.Get("server_type").(string)) == "" {
+				return nil
+			}
+			for _, field := range fields {
+				if _, set, known := configuredString(d, field); known && !set {
+					if err := d.SetNewComputed(field); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+
+		identity, err := parseCloudIdentity(d.Get("server_type").(string), d.Get("asset_id").(string), arn)
+		if err != nil {
+			if arn != "" {
+				return cty.GetAttrPath("arn").NewError(err)
+			}
+			return cty.GetAttrPath("asset_id").NewError(err)
+		}
+		if identity == nil {
+			return nil
+		}
+
+		var errs []error
+		for _, field := range fields {
+			derived, found := identity.Fields[field]
+			if !found {
+				continue
+			}
+			value, set, known := configuredString(d, field)
+			if !known {
+				continue
+			}
+			if !set {
+				if d.Get(field).(string) != derived || !d.NewValueKnown(field) {
+					log.Printf("[DEBUG] Setting %s to %q from %s %q\n", field, derived, identity.Source, identity.Identifier)
+					if err := d.SetNew(field, derived); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if !strings.EqualFold(value, derived) && !overridableCloudIdentityFields[field] {
+				errs = append(errs, cty.GetAttrPath(field).NewErrorf("%s %q contradicts the %s %q in %s %q, remove %s to derive it from %s", field, value, field, derived, identity.Source, identity.Identifier, field, identity.Source))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,230 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseCloudIdentity(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestParseCloudIdentity \n")
+
+	testCases := []struct {
+		serverType string
+		assetId    string
+		arn        string
+		source     string
+		fields     map[string]string
+	}{
+		{"AWS RDS MYSQL", "arn:aws:rds:us-east-2:123456789012:db:my-db", "", "asset_id", map[string]string{"region": "us-east-2"}},
+		{"AWS RDS MYSQL", "my-db", "arn:aws:rds:eu-west-1:123456789012:db:my-db", "arn", map[string]string{"region": "eu-west-1"}},
+		{"AWS", "arn:aws:iam::123456789012:role/my-role", "", "asset_id", map[string]string{}},
+		{"AZURE SQL SERVER", "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.Sql/servers/my-server", "", "asset_id", map[string]string{"subscription_id": "0000-1111"}},
+		{"GCP BIGQUERY", "projects/my-project/locations/us-central1/datasets/my-dataset", "", "asset_id", map[string]string{"location": "us-central1", "project": "my-project", "server_host_name": "bigquery.googleapis.com"}},
+		{"GCP MYSQL", "//sqladmin.googleapis.com/projects/my-project/regions/europe-west1/instances/my-db", "", "asset_id", map[string]string{"project": "my-project", "region": "europe-west1"}},
+		{"AWS DYNAMODB", "arn:aws:dynamodb:us-east-1:123456789012:table/my-table", "", "asset_id", map[string]string{"region": "us-east-1", "server_host_name": "dynamodb.us-east-1.amazonaws.com"}},
+		{"AWS LOG GROUP", "arn:aws-cn:logs:cn-north-1:123456789012:log-group:my-group:*", "", "asset_id", map[string]string{"region": "cn-north-1", "server_host_name": "logs.cn-north-1.amazonaws.com.cn"}},
+		{"AZURE MS SQL SERVER", "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.Sql/servers/My-Server/databases/my-db", "", "asset_id", map[string]string{"server_host_name": "my-server.database.windows.net", "subscription_id": "0000-1111"}},
+		{"AZURE EVENTHUB", "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.EventHub/namespaces/my-namespace/eventhubs/my-eventhub", "", "asset_id", map[string]string{"server_host_name": "my-namespace.servicebus.windows.net", "subscription_id": "0000-1111"}},
+		{"AZURE MYSQL FLEXIBLE", "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.DBforMySQL/servers/my-server", "", "asset_id", map[string]string{"subscription_id": "0000-1111"}},
+		{"GCP PUBSUB", "projects/my-project/subscriptions/my-subscription", "", "asset_id", map[string]string{"project": "my-project", "server_host_name": "pubsub.googleapis.com"}},
+	}
+	for _, testCase := range testCases {
+		identity, err := parseCloudIdentity(testCase.serverType, testCase.assetId, testCase.arn)
+		if err != nil {
+			t.Errorf("Should not have received an error for %s: %s", testCase.assetId, err)
+			continue
+		}
+		if identity == nil {
+			t.Errorf("Should have derived fields from %s", testCase.assetId)
+			continue
+		}
+		if identity.Source != testCase.source {
+			t.Errorf("Should have derived fields from %s. Got: %s", testCase.source, identity.Source)
+		}
+		if !reflect.DeepEqual(identity.Fields, testCase.fields) {
+			t.Errorf("Should have derived %v from %s. Got: %v", testCase.fields, testCase.assetId, identity.Fields)
+		}
+	}
+
+	// assets without a cloud identifier have nothing to derive
+	for serverType, assetId := range map[string]string{"MYSQL": "arn:aws:rds:us-east-2:123456789012:db:my-db", "AWS RDS MYSQL": "my-db", "AZURE SQL SERVER": "my-server"} {
+		identity, err := parseCloudIdentity(serverType, assetId, "")
+		if err != nil || identity != nil {
+			t.Errorf("Should not have derived fields for %s %s. Got: %v, %v", serverType, assetId, identity, err)
+		}
+	}
+
+	for serverType, assetId := range map[string]string{"AWS RDS MYSQL": "arn:aws:rds", "AZURE SQL SERVER": "/subscriptions//resourceGroups/my-group", "GCP BIGQUERY": "projects//datasets/my-dataset"} {
+		if _, err := parseCloudIdentity(serverType, assetId, ""); err == nil {
+			t.Errorf("Should have received an error for %s", assetId)
+		}
+	}
+}
+
+func TestCloudIdentityCustomizeDiffDerivesFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCloudIdentityCustomizeDiffDerivesFields \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "arn:aws:rds:us-east-2:123456789012:db:my-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "my-db.abcdefg.us-east-2.rds.amazonaws.com",
+		"server_type":        "AWS RDS MYSQL",
+	})
+
+	diff, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if diff.Attributes["region"] == nil || diff.Attributes["region"].New != "us-east-2" {
+		t.Errorf("Should have derived region us-east-2 from asset_id. Got: %v", diff.Attributes["region"])
+	}
+}
+
+func TestCloudIdentityCustomizeDiffDerivesServerHostName(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCloudIdentityCustomizeDiffDerivesServerHostName \n")
+
+	config := map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-sql-server",
+		"asset_id":           "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.Sql/servers/my-server",
+		"gateway_id":         "my-gateway",
+		"server_ip":          "10.0.0.1",
+		"server_type":        "AZURE MS SQL SERVER",
+	}
+	diff, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &Client{config: &Config{}})
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if diff.Attributes["server_host_name"] == nil || diff.Attributes["server_host_name"].New != "my-server.database.windows.net" {
+		t.Errorf("Should have derived server_host_name my-server.database.windows.net from asset_id. Got: %v", diff.Attributes["server_host_name"])
+	}
+
+	// a private endpoint does not contradict the asset_id
+	config["server_host_name"] = "my-server.privatelink.database.windows.net"
+	diff, err = resourceDSFDataSource().SimpleDiff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &Client{config: &Config{}})
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if diff.Attributes["server_host_name"] == nil || diff.Attributes["server_host_name"].New != "my-server.privatelink.database.windows.net" {
+		t.Errorf("Should have kept server_host_name my-server.privatelink.database.windows.net. Got: %v", diff.Attributes["server_host_name"])
+	}
+}
+
+func TestCloudIdentityCustomizeDiffUnknownAssetId(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCloudIdentityCustomizeDiffUnknownAssetId \n")
+
+	testCases := []struct {
+		serverType string
+		region     string
+		computed   bool
+	}{
+		{"AWS RDS MYSQL", "us-east-2", true},
+		{"MYSQL", "", false},
+	}
+	for _, tc := range testCases {
+		// the renamed asset is updated in place, so only the fields marked computed are recomputed
+		attributes := map[string]string{
+			"id":                 "my-mysql-db",
+			"admin_email":        testAdminEmail,
+			"allow_asset_rename": "true",
+			"asset_display_name": "my-mysql-db",
+			"asset_id":           "my-mysql-db",
+			"gateway_id":         "my-gateway",
+			"region":             tc.region,
+			"server_host_name":   "my-mysql-db.example.com",
+			"server_ip":          "10.0.0.1",
+			"server_type":        tc.serverType,
+		}
+		configValues := map[string]string{}
+		config := map[string]interface{}{}
+		for key, value := range attributes {
+			if key != "id" && key != "region" {
+				configValues[key] = value
+				config[key] = value
+			}
+		}
+		config["allow_asset_rename"] = true
+		config["asset_id"] = unknownVariableValue
+		rawConfig := testRawConfig(resourceDSFDataSource(), configValues).AsValueMap()
+		rawConfig["asset_id"] = cty.UnknownVal(cty.String)
+
+		state := &terraform.InstanceState{ID: "my-mysql-db", Attributes: attributes, RawConfig: cty.ObjectVal(rawConfig)}
+		diff, err := resourceDSFDataSource().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), &Client{config: &Config{}})
+		if err != nil {
+			t.Fatalf("%s: should not have received an error: %s", tc.serverType, err)
+		}
+		var computed bool
+		if diff != nil && diff.Attributes["region"] != nil {
+			computed = diff.Attributes["region"].NewComputed
+		}
+		if computed != tc.computed {
+			t.Errorf("%s: region should have been computed: %v. Got: %v", tc.serverType, tc.computed, computed)
+		}
+	}
+}
+
+func TestCloudIdentityCustomizeDiffContradiction(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestCloudIdentityCustomizeDiffContradiction \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-sql-server",
+		"asset_id":           "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.Sql/servers/my-server",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "my-server.database.windows.net",
+		"server_type":        "AZURE SQL SERVER",
+		"subscription_id":    "2222-3333",
+	})
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.Contains(err.Error(), "subscription_id \"2222-3333\" contradicts the subscription_id \"0000-1111\" in asset_id") {
+		t.Errorf("Should have received a contradiction error for subscription_id, got: %s", err)
+	}
+}
+
+func TestRequiredFieldsValidatorDerivedServerHostName(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRequiredFieldsValidatorDerivedServerHostName \n")
+
+	r := testConfiguredResource(dsfDataSourceResourceType, &Client{config: &Config{}})
+	values := map[string]string{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-sql-server",
+		"asset_id":           "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.Sql/servers/my-server",
+		"gateway_id":         "my-gateway",
+		"server_ip":          "10.0.0.1",
+		"server_type":        "AZURE MS SQL SERVER",
+	}
+	for _, d := range testValidateRawConfig(r, testRawConfig(r, values)) {
+		if d.AttributePath.Equals(cty.GetAttrPath("server_host_name")) {
+			t.Errorf("Should not have reported server_host_name, which is derived from asset_id. Got: %s", d.Summary)
+		}
+	}
+
+	// the host name of a SQL managed instance is not derived
+	values["server_type"] = "AZURE SQL MANAGED INSTANCE"
+	values["asset_id"] = "/subscriptions/0000-1111/resourceGroups/my-group/providers/Microsoft.Sql/managedInstances/my-instance"
+	var reported bool
+	for _, d := range testValidateRawConfig(r, testRawConfig(r, values)) {
+		reported = reported || d.AttributePath.Equals(cty.GetAttrPath("server_host_name"))
+	}
+	if !reported {
+		t.Errorf("Should have reported the missing server_host_name")
+	}
+}
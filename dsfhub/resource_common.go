@@ -4,19 +4,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math/big"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema.ResourceData) {
-	assetSchema := getSchema()
+func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema.ResourceData, definitions *assetDefinitions) {
+	assetSchema := getSchema(definitions)
 	//  Iterate through dsfDataSourceData.Data struct fields, retrieve value from d.get() using schema field.id
 	structDataFieldsAry := reflect.Indirect(reflect.ValueOf(&dsfDataSource.Data))
 	structDataFieldKeys := reflect.ValueOf(&dsfDataSource.Data).Elem()
@@ -48,7 +53,14 @@ func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema
 		log.Printf("[DEBUG] checking for field in assetSchema: %v\n", curStructField.Name)
 		if schemaField, found := assetSchema.Details[curStructField.Name]; found {
 			log.Printf("[DEBUG] field curStructField.Name '%v' present in assetSchema\n", curStructField.Name)
-			if curStructField.Name != "Connections" {
+			if curStructField.Name == "Archive" {
+				// the hub archives files when archive is not sent, so false is
+				// sent as well when it is set
+				if value, found := d.GetOkExists(schemaField.ID); found {
+					archive := value.(bool)
+					dsfDataSource.Data.AssetData.Archive = &archive
+				}
+			} else if curStructField.Name != "Connections" {
 				//Check to see if field value is set in tf input
 				if _, found := d.GetOk(schemaField.ID); found {
 					structField := structAssetDataFieldKeys.FieldByName(curStructField.Name)
@@ -87,6 +99,21 @@ func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema
 								}
 								dsfDataSource.Data.AssetData.AwsProxyConfig = &apc
 							}
+						case "service_endpoints":
+							inputVal := d.Get(schemaField.ID).(*schema.Set)
+							log.Printf("[DEBUG] service_endpoints: %v, %v", schemaField, inputVal)
+							for _, schemaFieldInt := range inputVal.List() {
+								se := ServiceEndpoints{}
+								schemaField := schemaFieldInt.(map[string]interface{})
+								for fieldName, fieldObjInt := range schemaField {
+									fieldObj := fieldObjInt.(interface{})
+									switch fieldName {
+									case "logs":
+										se.Logs = fieldObj.(string)
+									}
+								}
+								dsfDataSource.Data.AssetData.ServiceEndpoints = &se
+							}
 						}
 					} else {
 						if schemaField.ID == "server_port" {
@@ -117,11 +144,19 @@ func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema
 		}
 	}
 
+	// Fields that the provider does not model are merged into assetData
+	if value, found := d.GetOk("extra_asset_data"); found {
+		dsfDataSource.Data.AssetData.Extra = extraDataValue(value)
+	}
+
 	//  Iterate through asset_connection blocks in resource input
 	var connectionsAry = make([]AssetConnection, 0)
-	connections := d.Get("asset_connection").(*schema.Set)
-	for _, conn := range connections.List() {
-		connection := conn.(map[string]interface{})
+	for i, conn := range d.Get("asset_connection").([]interface{}) {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		applyWriteOnlyCredentials(d, i, connection)
 		curConnection := AssetConnection{}
 		curConnection.Reason = connection["reason"].(string)
 		//  Iterate through dsfDataSourceData.Data.AssetData.Connections struct fields, retrieve value from d.get() using schema field.id
@@ -213,22 +248,6 @@ func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema
 									curConnection.ConnectionData.HashicorpSecret = &hs
 								}
 							}
-						case "oauth_parameters":
-							inputVal := connection[schemaField.ID].(*schema.Set)
-							if inputVal.Len() > 0 {
-								for _, schemaFieldInt := range inputVal.List() {
-									op := OauthParameters{}
-									schemaField := schemaFieldInt.(map[string]interface{})
-									for fieldName, fieldObjInt := range schemaField {
-										fieldObj := fieldObjInt.(interface{})
-										switch fieldName {
-										case "parameter":
-											op.Parameter = fieldObj.(string)
-										}
-									}
-									curConnection.ConnectionData.OauthParameters = &op
-								}
-							}
 						}
 					} else {
 						if reflect.TypeOf(paramVal) != nil {
@@ -244,6 +263,11 @@ func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema
 							case reflect.String:
 								log.Printf("[DEBUG] schemaField.ID %v, Type=String: %v\n", schemaField.ID, value)
 								value := connection[schemaField.ID].(string)
+								// a credential masked by the hub is never sent back, it would overwrite the actual value
+								if isMaskedSecret(value) && contains(credentialConnectionFields, schemaField.ID) {
+									log.Printf("[DEBUG] Not sending asset_connection.%s, it is masked\n", schemaField.ID)
+									continue
+								}
 								structField.SetString(value)
 							case reflect.Bool:
 								log.Printf("[DEBUG] schemaField.ID %v, Type=Bool: %v\n", schemaField.ID, value)
@@ -255,9 +279,13 @@ func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema
 									log.Printf("[DEBUG] slice value v: %v\n", v)
 								}
 								structField.Set(reflect.ValueOf(value))
-							//case reflect.Map:
-							//	log.Printf("Map: %v\n", value)
-							//	// Handle maps here
+							case reflect.Map:
+								log.Printf("[DEBUG] schemaField.ID %v, Type=Map: %v\n", schemaField.ID, value)
+								parameters := make(map[string]string)
+								for parameterName, parameterValue := range connection[schemaField.ID].(map[string]interface{}) {
+									parameters[parameterName] = parameterValue.(string)
+								}
+								structField.Set(reflect.ValueOf(parameters))
 							default:
 								log.Printf("[DEBUG] Unknown type for field %v connection[schemaField.ID]: Type:%v\n", schemaField.ID, reflect.TypeOf(paramVal))
 							}
@@ -270,13 +298,31 @@ func createResource(dsfDataSource *ResourceWrapper, serverType string, d *schema
 				log.Printf("[DEBUG] Parsing connection fields, assetSchema.Connections[%v] not found: %v", curStructField.Name, assetSchema.Connections[curStructField.Name])
 			}
 		}
+		curConnection.ConnectionData.Extra = extraDataValue(connection["extra_connection_data"])
 		connectionsAry = append(connectionsAry, curConnection)
 	}
 	dsfDataSource.Data.AssetData.Connections = connectionsAry
 }
 
-func checkResourceRequiredFields(requiredFieldsJson string, ignoreParamsByServerType map[string]map[string]bool, d *schema.ResourceData) (bool, error) {
-	missingParams := []string{}
+// unknownVariableValue is the placeholder used by the SDK for values that are
+// not known until apply
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// resourceFieldReader is implemented by both schema.ResourceData and
+// schema.ResourceDiff, so that field checks can run at plan and apply time
+type resourceFieldReader interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+// missingRequiredFields returns the path of each field that is required for
+// the server type and auth mechanisms of the resource but has not been set.
+// Fields for which isKnown returns false are not checked, nor are fields that
+// only loaded asset definitions know and the resource has no attribute for. An
+// error is returned if the server type or an auth mechanism is not supported.
+func missingRequiredFields(requiredFieldsJson string, ignoreParamsByServerType map[string]map[string]bool, d resourceFieldReader, isKnown func(string) bool) ([]string, []cty.Path, error) {
+	var missingParams []string
+	var missingPaths []cty.Path
 	var requiredFields RequiredFieldsMap
 	err := json.Unmarshal([]byte(requiredFieldsJson), &requiredFields)
 	if err != nil {
@@ -284,17 +330,29 @@ func checkResourceRequiredFields(requiredFieldsJson string, ignoreParamsByServer
 		panic(err)
 	}
 
+	if !isKnown("server_type") {
+		return nil, nil, nil
+	}
 	serverType := d.Get("server_type").(string)
 	serverTypeObj, found := requiredFields.ServerType[serverType]
 	if !found {
-		return false, fmt.Errorf("unsupported serverType: %s\n", serverType)
+		return nil, nil, cty.GetAttrPath("server_type").NewErrorf("unsupported serverType: %s%s\n", serverType, didYouMean(serverType, requiredFieldsServerTypes(requiredFieldsJson)))
 	}
 	for _, field := range serverTypeObj.Required {
+		if !modelledAssetFields[field] {
+			log.Printf("[DEBUG] Skipping field '%s' required for serverType '%s' that has no attribute\n", field, serverType)
+			continue
+		}
+		if !isKnown(field) {
+			log.Printf("[DEBUG] Skipping unknown field '%s' for serverType '%s'\n", field, serverType)
+			continue
+		}
 		curField := d.Get(field)
 		log.Printf("[DEBUG] Checking for field: '%v', curField: %v, reflect.TypeOf() '%v'\n", field, curField, reflect.ValueOf(d.Get(field)))
 		if _, ok := d.GetOk(field); !ok {
 			if _, found := ignoreParamsByServerType[serverType][field]; !found {
 				missingParams = append(missingParams, field)
+				missingPaths = append(missingPaths, cty.GetAttrPath(field))
 				log.Printf("[DEBUG] ERROR: Missing required field '%s' for serverType '%s'\n", field, serverType)
 			} else {
 				log.Printf("[INFO] Ignoring missing required field '%s' for serverType '%s'\n", field, serverType)
@@ -302,21 +360,34 @@ func checkResourceRequiredFields(requiredFieldsJson string, ignoreParamsByServer
 		}
 	}
 
-	connections := d.Get("asset_connection").(*schema.Set)
-	for _, conn := range connections.List() {
-		connection := conn.(map[string]interface{})
-		authMechanism := connection["auth_mechanism"].(string)
+	if !isKnown("asset_connection") {
+		return missingParams, missingPaths, nil
+	}
+	for i, conn := range d.Get("asset_connection").([]interface{}) {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		authMechanism, _ := connection["auth_mechanism"].(string)
+		if authMechanism == unknownVariableValue {
+			continue
+		}
 		log.Printf("[DEBUG] Checking for authMechanism: %s\n", authMechanism)
 		authMechanismFields, found := serverTypeObj.AuthMechanisms[authMechanism]
 		if !found {
-			return false, fmt.Errorf("unsupported authMechanism '%v' for serverType '%v'\n", authMechanism, serverType)
+			return nil, nil, cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr("auth_mechanism").NewErrorf("unsupported authMechanism '%v' for serverType '%v'%s\n", authMechanism, serverType, didYouMean(authMechanism, serverTypeObj.authMechanismNames()))
 		}
 		for _, field := range authMechanismFields {
+			if !modelledAssetFields[field] {
+				log.Printf("[DEBUG] Skipping connection field '%s' required for serverType '%s' that has no attribute\n", field, serverType)
+				continue
+			}
 			log.Printf("[DEBUG] Checking for field: '%s', value: '%s'\n", field, connection[field])
 			val := fmt.Sprintf("%v", connection[field])
-			if _, found := connection[field]; !found || strings.Trim(val, " ") == "" {
+			if _, found := connection[field]; (!found || strings.Trim(val, " ") == "") && !writeOnlyCredentialSet(connection, field) {
 				if _, found := ignoreParamsByServerType[serverType][field]; !found {
 					missingParams = append(missingParams, field)
+					missingPaths = append(missingPaths, cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr(field))
 					log.Printf("[DEBUG] Missing required connection field '%s' for serverType '%s' with auth_mechanism '%s'\n", field, serverType, authMechanism)
 				} else {
 					log.Printf("[INFO] Ignoring missing required connection field '%s' for serverType '%s' with auth_mechanism '%s'\n", field, serverType, authMechanism)
@@ -324,13 +395,358 @@ func checkResourceRequiredFields(requiredFieldsJson string, ignoreParamsByServer
 			}
 		}
 	}
+	return missingParams, missingPaths, nil
+}
+
+// unusedConnectionField is an asset_connection field that has been set but has
+// no effect for the auth_mechanism of the connection
+type unusedConnectionField struct {
+	// Index is the index of the asset_connection block
+	Index         int
+	AuthMechanism string
+	Field         string
+}
+
+// path returns the path of the field in the configuration
+func (f unusedConnectionField) path() cty.Path {
+	return cty.GetAttrPath("asset_connection").IndexInt(f.Index).GetAttr(f.Field)
+}
+
+// authMechanismAllowedFields returns the credential fields used by each
+// auth_mechanism, and the credential fields checked for all of them
+func authMechanismAllowedFields(definitions *assetDefinitions) (map[string][]string, []string) {
+	var allowedFields RequiredFields
+	err := json.Unmarshal([]byte(definitions.authMechanismAllowedFieldsJson), &allowedFields)
+	if err != nil {
+		log.Printf("[DEBUG] json.Unmarshal([]byte(authMechanismAllowedFieldsJson), &allowedFields) %s:\n", err)
+		panic(err)
+	}
+
+	var checkedFields []string
+	for _, fields := range allowedFields.AuthMechanisms {
+		for _, field := range fields {
+			if !contains(checkedFields, field) {
+				checkedFields = append(checkedFields, field)
+			}
+		}
+	}
+	sort.Strings(checkedFields)
+	return allowedFields.AuthMechanisms, checkedFields
+}
+
+// findUnusedConnectionFields returns the credential fields of each
+// asset_connection that are set but are neither required nor allowed for the
+// server type and auth_mechanism of the connection. The fields allowed are
+// listed per server type in allowed_auth_mechanisms, or for server types that
+// do not list them, e.g. those loaded from the hub, in
+// authMechanismAllowedFieldsJson.
+func findUnusedConnectionFields(definitions *assetDefinitions, resourceType string, d resourceFieldReader) []unusedConnectionField {
+	var requiredFields RequiredFieldsMap
+	err := json.Unmarshal([]byte(definitions.requiredFieldsJson[resourceType]), &requiredFields)
+	if err != nil {
+		log.Printf("[DEBUG] json.Unmarshal([]byte(requiredFieldsJson), &requiredFields) %s:\n", err)
+		panic(err)
+	}
+	defaultAllowedFields, checkedFields := authMechanismAllowedFields(definitions)
+
+	var unusedFields []unusedConnectionField
+	serverTypeObj := requiredFields.ServerType[d.Get("server_type").(string)]
+	connections, ok := d.Get("asset_connection").([]interface{})
+	if !ok {
+		return nil
+	}
+	for i, conn := range connections {
+		connection, ok := conn.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		authMechanism, _ := connection["auth_mechanism"].(string)
+
+		allowedFields, found := serverTypeObj.AllowedAuthMechanisms[authMechanism]
+		if !found {
+			allowedFields, found = defaultAllowedFields[authMechanism]
+		}
+		if !found {
+			log.Printf("[DEBUG] No allowed fields listed for authMechanism '%s', skipping unused field checks\n", authMechanism)
+			continue
+		}
+		allowedFields = append(append([]string{}, allowedFields...), serverTypeObj.AuthMechanisms[authMechanism]...)
+
+		for _, field := range checkedFields {
+			if contains(allowedFields, field) {
+				continue
+			}
+			if value, ok := connection[field]; ok && strings.TrimSpace(fmt.Sprintf("%v", value)) != "" {
+				log.Printf("[DEBUG] Field '%s' has no effect for authMechanism '%s'\n", field, authMechanism)
+				unusedFields = append(unusedFields, unusedConnectionField{Index: i, AuthMechanism: authMechanism, Field: field})
+			}
+		}
+	}
+	return unusedFields
+}
+
+// unusedConnectionFieldMessage describes an asset_connection field that has no
+// effect
+func unusedConnectionFieldMessage(serverType string, unusedField unusedConnectionField) string {
+	return fmt.Sprintf("asset_connection field \"%s\" has no effect for serverType '%s' with auth_mechanism '%s'", unusedField.Field, serverType, unusedField.AuthMechanism)
+}
+
+// unusedConnectionFieldsCustomizeDiff fails the plan when asset_connection
+// fields that have no effect are set and unused_connection_fields is "error"
+func unusedConnectionFieldsCustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if m.(*Client).config.UnusedConnectionFields != "error" {
+			return nil
+		}
+		if !d.NewValueKnown("server_type") || !d.NewValueKnown("asset_connection") {
+			return nil
+		}
+
+		serverType := d.Get("server_type").(string)
+		var errs []error
+		for _, unusedField := range findUnusedConnectionFields(m.(*Client).assetDefinitions(), resourceType, d) {
+			errs = append(errs, unusedField.path().NewErrorf("%s", unusedConnectionFieldMessage(serverType, unusedField)))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// unusedConnectionFieldsValidator returns a warning for each asset_connection
+// field that has no effect, when unused_connection_fields is "warn". Terraform
+// validates the configuration again when planning it, once the provider is
+// configured: meta returns nil before, and nothing is reported then.
+func unusedConnectionFieldsValidator(resourceType string, resourceSchema map[string]*schema.Schema, meta func() interface{}) schema.ValidateRawResourceConfigFunc {
+	return func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+		client, ok := meta().(*Client)
+		if !ok || !req.RawConfig.IsKnown() || req.RawConfig.IsNull() {
+			return
+		}
+		if mode := client.config.UnusedConnectionFields; mode != "" && mode != "warn" {
+			return
+		}
+		config := rawConfigReader{config: req.RawConfig, schema: resourceSchema}
+		if !config.isKnown("server_type") {
+			return
+		}
+
+		serverType := config.Get("server_type").(string)
+		for _, unusedField := range findUnusedConnectionFields(client.assetDefinitions(), resourceType, config) {
+			resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       unusedConnectionFieldMessage(serverType, unusedField),
+				Detail:        "The DSF Hub ignores this field. Remove it from the asset_connection block, or set unused_connection_fields = \"error\" on the provider to fail the plan instead.",
+				AttributePath: unusedField.path(),
+			})
+		}
+	}
+}
+
+// missingRequiredFieldsMessage describes the required fields missing from a
+// resource
+func missingRequiredFieldsMessage(resourceType string, serverType string, missingParams []string) string {
+	return fmt.Sprintf("missing required fields for %s with serverType '%s', missing fields: %s", resourceType, serverType, "\""+strings.Join(missingParams, ", ")+"\"")
+}
+
+func checkResourceRequiredFields(resourceType string, ignoreParamsByServerType map[string]map[string]bool, d *schema.ResourceData, definitions *assetDefinitions) (bool, error) {
+	missingParams, _, err := missingRequiredFields(definitions.requiredFieldsJson[resourceType], ignoreParamsByServerType, d, func(string) bool { return true })
+	if err != nil {
+		return false, err
+	}
 	if len(missingParams) > 0 {
-		return false, fmt.Errorf("missing required fields for dsfhub_data_source with serverType '%s', missing fields: %s\n", serverType, "\""+strings.Join(missingParams, ", ")+"\"")
+		return false, fmt.Errorf("%s\n", missingRequiredFieldsMessage(resourceType, d.Get("server_type").(string), missingParams))
 	} else {
 		return true, nil
 	}
 }
 
+// requiredFieldsValidator fails the validation of the configuration with one
+// diagnostic per missing required field, attached to the path of the field.
+// Fields that are not known yet, or that are not set and may be derived at
+// plan time like asset_id or region, are left to requiredFieldsCustomizeDiff,
+// and so are unsupported server types and auth mechanisms. The required fields
+// depend on the asset definitions of the provider configuration: like
+// unusedConnectionFieldsValidator, the fields are checked when Terraform
+// validates the configuration again at plan time, once the provider is
+// configured.
+func requiredFieldsValidator(resourceType string, ignoreParamsByServerType map[string]map[string]bool, resourceSchema map[string]*schema.Schema, meta func() interface{}) schema.ValidateRawResourceConfigFunc {
+	return func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+		client, ok := meta().(*Client)
+		if !ok || !req.RawConfig.IsKnown() || req.RawConfig.IsNull() {
+			return
+		}
+		config := rawConfigReader{config: req.RawConfig, schema: resourceSchema}
+		isKnown := func(field string) bool {
+			if config.isKnown(field) {
+				return true
+			}
+			// a computed field that is not set stays empty unless it is derived
+			return config.isNull(field) && field != "asset_id" && !cloudIdentityFieldDerivable(config, field)
+		}
+		missingParams, missingPaths, err := missingRequiredFields(client.assetDefinitions().requiredFieldsJson[resourceType], ignoreParamsByServerType, config, isKnown)
+		if err != nil {
+			log.Printf("[DEBUG] Not validating the required fields of the configuration | err: %s\n", err)
+			return
+		}
+		serverType := config.Get("server_type").(string)
+		for i, field := range missingParams {
+			resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       missingRequiredFieldsMessage(resourceType, serverType, []string{field}),
+				Detail:        fmt.Sprintf("The DSF Hub requires %s for serverType '%s', set it in the configuration.", field, serverType),
+				AttributePath: missingPaths[i],
+			})
+		}
+	}
+}
+
+// requiredFieldsCustomizeDiff runs the required field checks at plan time on
+// the fields that requiredFieldsValidator could not check, once their value is
+// known. Fields whose value is not known until apply are not checked.
+func requiredFieldsCustomizeDiff(resourceType string, ignoreParamsByServerType map[string]map[string]bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		missingParams, missingPaths, err := missingRequiredFields(m.(*Client).assetDefinitions().requiredFieldsJson[resourceType], ignoreParamsByServerType, d, plannedValueKnown(d))
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for i, field := range missingParams {
+			errs = append(errs, missingPaths[i].NewErrorf("%s", missingRequiredFieldsMessage(resourceType, d.Get("server_type").(string), []string{field})))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// plannedValueKnown returns whether the planned value of a field is known. A
+// computed field that is not set in the configuration of a new asset is
+// planned as unknown although it stays empty, unless a CustomizeDiff derives
+// it: it is only reported as unknown when a CustomizeDiff planned it as
+// computed, e.g. until the fields it is derived from are known.
+func plannedValueKnown(d *schema.ResourceDiff) func(string) bool {
+	updatedKeys := map[string]bool{}
+	for _, key := range d.UpdatedKeys() {
+		updatedKeys[key] = true
+	}
+	rawConfig := d.GetRawConfig()
+	return func(field string) bool {
+		if d.NewValueKnown(field) {
+			return true
+		}
+		if updatedKeys[field] || rawConfig.IsNull() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(field) {
+			return false
+		}
+		return rawConfig.GetAttr(field).IsNull()
+	}
+}
+
+// rawConfigReader implements resourceFieldReader over the raw configuration
+// of a resource, for the checks run when the configuration is validated. Like
+// with schema.ResourceData, an attribute that is not set reads as its default
+// or as the zero value of its type, and blocks read as lists of maps.
+type rawConfigReader struct {
+	config cty.Value
+	schema map[string]*schema.Schema
+}
+
+func (r rawConfigReader) Get(field string) interface{} {
+	attribute, found := r.schema[field]
+	if !found || !r.config.Type().IsObjectType() || !r.config.Type().HasAttribute(field) {
+		return nil
+	}
+	value := r.config.GetAttr(field)
+	switch {
+	case !value.IsKnown():
+		return unknownVariableValue
+	case value.IsNull() && attribute.Default != nil:
+		return attribute.Default
+	case value.IsNull():
+		return attribute.ZeroValue()
+	}
+	block, isBlock := attribute.Elem.(*schema.Resource)
+	if !isBlock {
+		return rawConfigValue(value)
+	}
+	blocks := []interface{}{}
+	for it := value.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		blockReader := rawConfigReader{config: element, schema: block.Schema}
+		values := map[string]interface{}{}
+		for name := range block.Schema {
+			values[name] = blockReader.Get(name)
+		}
+		blocks = append(blocks, values)
+	}
+	return blocks
+}
+
+func (r rawConfigReader) GetOk(field string) (interface{}, bool) {
+	value := r.Get(field)
+	return value, !isEmptyAttributeValue(value)
+}
+
+// isKnown returns false for the attributes whose value is not known yet,
+// including the attributes that are not set and may be computed at plan time
+func (r rawConfigReader) isKnown(field string) bool {
+	attribute, found := r.schema[field]
+	if !found || !r.config.Type().IsObjectType() || !r.config.Type().HasAttribute(field) {
+		return false
+	}
+	value := r.config.GetAttr(field)
+	if _, isBlock := attribute.Elem.(*schema.Resource); isBlock {
+		// unknown values inside the blocks read as unknownVariableValue
+		return value.IsKnown()
+	}
+	return value.IsWhollyKnown() && !(value.IsNull() && attribute.Computed)
+}
+
+// isNull returns whether a field is known and not set in the configuration
+func (r rawConfigReader) isNull(field string) bool {
+	if !r.config.Type().IsObjectType() || !r.config.Type().HasAttribute(field) {
+		return false
+	}
+	value := r.config.GetAttr(field)
+	return value.IsKnown() && value.IsNull()
+}
+
+// rawConfigValue converts a configuration value to the type used for it by
+// schema.ResourceData
+func rawConfigValue(value cty.Value) interface{} {
+	if !value.IsKnown() {
+		return unknownVariableValue
+	}
+	if value.IsNull() {
+		return nil
+	}
+	valueType := value.Type()
+	switch {
+	case valueType == cty.String:
+		return value.AsString()
+	case valueType == cty.Bool:
+		return value.True()
+	case valueType == cty.Number:
+		if i, accuracy := value.AsBigFloat().Int64(); accuracy == big.Exact {
+			return int(i)
+		}
+		f, _ := value.AsBigFloat().Float64()
+		return f
+	case valueType.IsListType() || valueType.IsSetType() || valueType.IsTupleType():
+		values := []interface{}{}
+		for it := value.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			values = append(values, rawConfigValue(element))
+		}
+		return values
+	case valueType.IsMapType() || valueType.IsObjectType():
+		values := map[string]interface{}{}
+		for it := value.ElementIterator(); it.Next(); {
+			key, element := it.Element()
+			values[key.AsString()] = rawConfigValue(element)
+		}
+		return values
+	}
+	return nil
+}
+
 func populateStructField(structField *reflect.Value, schemaField SchemaField, d *schema.ResourceData) {
 	//log.Printf("structField: %v, d.get: %v", schemaField.ID, d.Get(schemaField.ID))
 	if structField.IsValid() {
@@ -375,7 +791,12 @@ func populateStructField(structField *reflect.Value, schemaField SchemaField, d
 	}
 }
 
-func getSchema() AssetSchema {
+// getSchema returns the asset schema of the asset definitions
+func getSchema(definitions *assetDefinitions) AssetSchema {
+	return getSchemaFromJson(definitions.assetSchemaJson)
+}
+
+func getSchemaFromJson(assetSchemaJson string) AssetSchema {
 	var assetSchema AssetSchema
 	err := json.Unmarshal([]byte(assetSchemaJson), &assetSchema)
 	if err != nil {
@@ -421,6 +842,40 @@ func readAsset(client Client, resourceType string, assetId string) (*ResourceWra
 	return result, nil
 }
 
+// enableAudit calls the enable-audit-collection operation of an asset using
+// the endpoint matching its resource type
+func enableAudit(client Client, resourceType string, assetId string) (*UpdateAuditResponse, error) {
+	enableFuncs := map[string]func(string) (*UpdateAuditResponse, error){
+		dsfDataSourceResourceType:    client.EnableAuditDSFDataSource,
+		dsfLogAggregatorResourceType: client.EnableAuditLogAggregator,
+	}
+
+	enableFn, ok := enableFuncs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("audit collection is not supported for resourceType: %v", resourceType)
+	}
+
+	log.Printf("[INFO] enabling audit for %s asset %v", resourceType, assetId)
+	return enableFn(assetId)
+}
+
+// disableAudit calls the disable-audit-collection operation of an asset using
+// the endpoint matching its resource type
+func disableAudit(client Client, resourceType string, assetId string) (*UpdateAuditResponse, error) {
+	disableFuncs := map[string]func(string) (*UpdateAuditResponse, error){
+		dsfDataSourceResourceType:    client.DisableAuditDSFDataSource,
+		dsfLogAggregatorResourceType: client.DisableAuditLogAggregator,
+	}
+
+	disableFn, ok := disableFuncs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("audit collection is not supported for resourceType: %v", resourceType)
+	}
+
+	log.Printf("[INFO] disabling audit for %s asset %v", resourceType, assetId)
+	return disableFn(assetId)
+}
+
 // waitUntilAuditState reads an asset periodically to check the status of audit_pull_enabled
 func waitUntilAuditState(ctx context.Context, desiredState bool, resourceType string, assetId string, m interface{}) error {
 	client := m.(*Client)
@@ -531,13 +986,11 @@ func connectDisconnectGateway(ctx context.Context, d *schema.ResourceData, resou
 	auditPullEnabled := d.Get("audit_pull_enabled").(bool)
 	auditType := d.Get("audit_type").(string)
 	auditPullEnabledChanged := d.HasChange("audit_pull_enabled")
-	auditTypeChanged := d.HasChange("audit_type")
 
 	log.Printf("[DEBUG] connectDisconnectGateway - assetId: %v", assetId)
 	log.Printf("[DEBUG] connectDisconnectGateway - auditPullEnabled: %v", auditPullEnabled)
 	log.Printf("[DEBUG] connectDisconnectGateway - auditType: %v", auditType)
 	log.Printf("[DEBUG] connectDisconnectGateway - auditPullEnabledChanged: %v", auditPullEnabledChanged)
-	log.Printf("[DEBUG] connectDisconnectGateway - auditTypeChanged: %v", auditTypeChanged)
 
 	// if audit_pull_enabled has been changed, connect/disconnect from gateway as needed
 	if auditPullEnabledChanged {
@@ -554,9 +1007,8 @@ func connectDisconnectGateway(ctx context.Context, d *schema.ResourceData, resou
 		}
 		// if asset is already connected, check whether relevant fields have been updated and reconnect to gateway
 	} else if auditPullEnabled {
-		if auditTypeChanged {
-			origAuditType, newAuditType := d.GetChange("audit_type")
-			log.Printf("[INFO] auditType value has changed from %s to %s, reconnecting asset to gateway\n", origAuditType, newAuditType)
+		if changedAttributes := changedReconnectAttributes(d, resourceType); len(changedAttributes) > 0 {
+			log.Printf("[INFO] %s value has changed, reconnecting asset to gateway\n", strings.Join(changedAttributes, ", "))
 			err := reconnectGateway(ctx, m, assetId, resourceType)
 			if err != nil {
 				return err
@@ -568,10 +1020,119 @@ func connectDisconnectGateway(ctx context.Context, d *schema.ResourceData, resou
 	return nil
 }
 
+// reconnectGatewayAttributes lists, per resource type, the attributes that
+// cause a connected asset to be reconnected to gateway when changed, so that
+// the gateway does not keep collecting audit with a stale configuration. A log
+// aggregator is itself a logs destination and is located by its identifier, so
+// logs_destination_asset_id and region do not change where its audit is read.
+var reconnectGatewayAttributes = map[string][]string{
+	dsfDataSourceResourceType: {
+		"asset_connection",
+		"audit_type",
+		"logs_destination_asset_id",
+		"parent_asset_id",
+		"region",
+		"server_host_name",
+		"server_ip",
+		"server_port",
+	},
+	dsfLogAggregatorResourceType: {
+		"asset_connection",
+		"audit_type",
+		"parent_asset_id",
+		"server_host_name",
+		"server_ip",
+		"server_port",
+	},
+}
+
+// reconnectAttributes returns the default reconnect-triggering attributes for
+// the resource type combined with the ones listed in reconnect_on_change
+func reconnectAttributes(resourceType string, reconnectOnChange []interface{}) []string {
+	attributes := append([]string{}, reconnectGatewayAttributes[resourceType]...)
+	for _, attribute := range reconnectOnChange {
+		if attribute == nil || contains(attributes, attribute.(string)) {
+			continue
+		}
+		attributes = append(attributes, attribute.(string))
+	}
+	return attributes
+}
+
+// changedReconnectAttributes returns the reconnect-triggering attributes that
+// have changed for the resource
+func changedReconnectAttributes(d interface {
+	Get(string) interface{}
+	HasChange(string) bool
+}, resourceType string) []string {
+	var changed []string
+	for _, attribute := range reconnectAttributes(resourceType, d.Get("reconnect_on_change").([]interface{})) {
+		if d.HasChange(attribute) {
+			changed = append(changed, attribute)
+		}
+	}
+	return changed
+}
+
+// reconnectGatewayCustomizeDiff flags at plan time that an apply will
+// reconnect an already connected asset to gateway, briefly interrupting audit
+// collection. The apply clears the reason, so that it is never kept in the
+// state.
+func reconnectGatewayCustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		// new assets and assets being connected/disconnected are not reconnected
+		oldAuditPullEnabled, newAuditPullEnabled := d.GetChange("audit_pull_enabled")
+		if d.Id() == "" || !oldAuditPullEnabled.(bool) || !newAuditPullEnabled.(bool) {
+			return nil
+		}
+
+		changed := changedReconnectAttributes(d, resourceType)
+		if len(changed) == 0 {
+			return nil
+		}
+
+		reason := fmt.Sprintf("%s changed: audit collection will be briefly interrupted while the asset is reconnected to gateway", strings.Join(changed, ", "))
+		log.Printf("[WARN] %s asset %s: %s\n", resourceType, d.Get("asset_id"), reason)
+		return d.SetNew("audit_reconnect_reason", reason)
+	}
+}
+
+// reconnectOnChangeValidator fails the validation of the configuration when
+// reconnect_on_change lists a name that is not an attribute of the resource,
+// which would never trigger a reconnect
+func reconnectOnChangeValidator(resourceSchema map[string]*schema.Schema) schema.ValidateRawResourceConfigFunc {
+	var attributes []string
+	for name, attribute := range resourceSchema {
+		if attribute.Optional || attribute.Required {
+			attributes = append(attributes, name)
+		}
+	}
+	sort.Strings(attributes)
+
+	return func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+		if !req.RawConfig.IsKnown() || req.RawConfig.IsNull() || !req.RawConfig.Type().HasAttribute("reconnect_on_change") {
+			return
+		}
+		reconnectOnChange := req.RawConfig.GetAttr("reconnect_on_change")
+		if !reconnectOnChange.IsKnown() || reconnectOnChange.IsNull() {
+			return
+		}
+		for it := reconnectOnChange.ElementIterator(); it.Next(); {
+			key, element := it.Element()
+			if !element.IsKnown() || element.IsNull() {
+				continue
+			}
+			index, _ := key.AsBigFloat().Int64()
+			path := cty.GetAttrPath("reconnect_on_change").IndexInt(int(index))
+			resp.Diagnostics = append(resp.Diagnostics, enumValueDiagnostics("reconnect_on_change", element.AsString(), attributes, path)...)
+		}
+	}
+}
+
 // connectGateway connects an asset to gateway
 func connectGateway(ctx context.Context, m interface{}, assetId string, resourceType string) error {
 	client := m.(*Client)
-	_, err := client.EnableAuditDSFDataSource(assetId)
+	_, err := enableAudit(*client, resourceType, assetId)
 	if err != nil {
 		log.Printf("[INFO] Error enabling audit for assetId: %s\n", assetId)
 		return err
@@ -580,14 +1141,17 @@ func connectGateway(ctx context.Context, m interface{}, assetId string, resource
 	// ensure asset is synced to gateway
 	err = waitForRemoteSyncState(ctx, resourceType, assetId, m)
 	if err != nil {
-		return err
+		return remoteSyncStateError(*client, resourceType, assetId, err)
 	}
 
 	// confirm asset is connected to gateway
-	isAuditPullEnabled, err := checkAuditState(ctx, m, assetId, resourceType)
-	if err != nil || !isAuditPullEnabled {
+	result, err := readAsset(*client, resourceType, assetId)
+	if err != nil {
 		return err
 	}
+	if !result.Data.AssetData.AuditPullEnabled {
+		return fmt.Errorf("audit collection was not enabled for asset %s (%s)", assetId, describeAuditState(result))
+	}
 
 	return nil
 }
@@ -595,7 +1159,7 @@ func connectGateway(ctx context.Context, m interface{}, assetId string, resource
 // disconnectGateway disconnects an asset from gateway
 func disconnectGateway(ctx context.Context, m interface{}, assetId string, resourceType string) error {
 	client := m.(*Client)
-	_, err := client.DisableAuditDSFDataSource(assetId)
+	_, err := disableAudit(*client, resourceType, assetId)
 	if err != nil {
 		log.Printf("[INFO] Error disabling audit for assetId: %s\n", assetId)
 		return err
@@ -605,18 +1169,53 @@ func disconnectGateway(ctx context.Context, m interface{}, assetId string, resou
 	err = waitForRemoteSyncState(ctx, resourceType, assetId, m)
 	if err != nil {
 		log.Printf("[INFO] Error while waiting for audit state to update for assetId: %s\n", assetId)
-		return err
+		return remoteSyncStateError(*client, resourceType, assetId, err)
 	}
 
 	// confirm asset is disconnected from gateway
-	isAuditPullEnabled, err := checkAuditState(ctx, m, assetId, resourceType)
-	if err != nil || isAuditPullEnabled {
+	result, err := readAsset(*client, resourceType, assetId)
+	if err != nil {
 		return err
 	}
+	if result.Data.AssetData.AuditPullEnabled {
+		return fmt.Errorf("audit collection was not disabled for asset %s (%s)", assetId, describeAuditState(result))
+	}
 
 	return nil
 }
 
+// describeAuditState summarizes the hub-side state of an asset that is
+// relevant to troubleshooting audit collection
+func describeAuditState(result *ResourceWrapper) string {
+	return fmt.Sprintf("audit_pull_enabled: %v, remoteSyncState: %q, auditState: %q, gatewayId: %q",
+		result.Data.AssetData.AuditPullEnabled,
+		result.Data.RemoteSyncState,
+		result.Data.AuditState,
+		result.Data.GatewayID,
+	)
+}
+
+// remoteSyncStateError wraps an error returned while waiting for an asset to
+// be synced to gateway with the asset's current audit state
+func remoteSyncStateError(client Client, resourceType string, assetId string, err error) error {
+	result, readErr := readAsset(client, resourceType, assetId)
+	if readErr != nil {
+		return fmt.Errorf("error while waiting for remoteSyncState = \"SYNCED\" for asset %s: %s", assetId, err)
+	}
+	return fmt.Errorf("error while waiting for remoteSyncState = \"SYNCED\" for asset %s (%s): %s", assetId, describeAuditState(result), err)
+}
+
+// auditDiagnosticSeverity returns the severity used to report a failure to
+// update the audit state of an asset. Failures are errors when strict_audit is
+// enabled on either the provider or the resource, and warnings otherwise.
+func auditDiagnosticSeverity(d *schema.ResourceData, m interface{}) diag.Severity {
+	client := m.(*Client)
+	if client.config.StrictAudit || d.Get("strict_audit").(bool) {
+		return diag.Error
+	}
+	return diag.Warning
+}
+
 // reconnectGateway first disconnects and then reconnects an asset to gateway
 func reconnectGateway(ctx context.Context, m interface{}, assetId string, resourceType string) error {
 	log.Printf("[INFO] Re-enabling audit for assetId: %s\n", assetId)
@@ -689,15 +1288,6 @@ func resourceConnectionDataHashicorpSecretHash(v interface{}) int {
 	return PositiveHash(buf.String())
 }
 
-func resourceConnectionDataOauthParametersHash(v interface{}) int {
-	var buf bytes.Buffer
-	m := v.(map[string]interface{})
-	if v, ok := m["parameter"]; ok {
-		buf.WriteString(fmt.Sprintf("%v-", v.(string)))
-	}
-	return PositiveHash(buf.String())
-}
-
 // AssetData resource hash functions
 func resourceAssetDataAuditInfoHash(v interface{}) int {
 	var buf bytes.Buffer
@@ -0,0 +1,586 @@
+package dsfhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+////////////////////////////////////////////////////////////////
+// enableAudit / disableAudit Tests
+////////////////////////////////////////////////////////////////
+
+// auditOperationEndpoints lists the audit operation endpoint expected for each
+// resource type supporting audit collection
+var auditOperationEndpoints = map[string]string{
+	dsfDataSourceResourceType:    endpointDsfDataSource,
+	dsfLogAggregatorResourceType: endpointLogAggregators,
+}
+
+func TestEnableAuditByResourceType(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestEnableAuditByResourceType \n")
+
+	for resourceType, collectionEndpoint := range auditOperationEndpoints {
+		endpoint := baseAPIPrefix + collectionEndpoint + "/" + url.PathEscape(testArn) + "/operations/enable-audit-collection"
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.URL.String() != endpoint {
+				t.Errorf("%s should have have hit %s endpoint. Got: %s", resourceType, endpoint, req.URL.String())
+			}
+			rw.Write([]byte(`{"data":"Audit collection enabled"}`))
+		}))
+
+		config := &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}
+		client := &Client{config: config, httpClient: &http.Client{}}
+
+		_, err := enableAudit(*client, resourceType, testArn)
+		if err != nil {
+			t.Errorf("Should not have received an error for %s: %s", resourceType, err)
+		}
+		server.Close()
+	}
+}
+
+func TestDisableAuditByResourceType(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestDisableAuditByResourceType \n")
+
+	for resourceType, collectionEndpoint := range auditOperationEndpoints {
+		endpoint := baseAPIPrefix + collectionEndpoint + "/" + url.PathEscape(testArn) + "/operations/disable-audit-collection"
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.URL.String() != endpoint {
+				t.Errorf("%s should have have hit %s endpoint. Got: %s", resourceType, endpoint, req.URL.String())
+			}
+			rw.Write([]byte(`{"data":"Audit collection disabled"}`))
+		}))
+
+		config := &Config{DSFHUBToken: "foo", DSFHUBHost: server.URL}
+		client := &Client{config: config, httpClient: &http.Client{}}
+
+		_, err := disableAudit(*client, resourceType, testArn)
+		if err != nil {
+			t.Errorf("Should not have received an error for %s: %s", resourceType, err)
+		}
+		server.Close()
+	}
+}
+
+func TestEnableAuditUnsupportedResourceType(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestEnableAuditUnsupportedResourceType \n")
+	config := &Config{DSFHUBToken: "foo", DSFHUBHost: testInvalidDSFHUBHost}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	_, err := enableAudit(*client, dsfCloudAccountResourceType, testArn)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("audit collection is not supported for resourceType: %s", dsfCloudAccountResourceType)) {
+		t.Errorf("Should have received an unsupported resourceType error, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// auditDiagnosticSeverity Tests
+////////////////////////////////////////////////////////////////
+
+func TestAuditDiagnosticSeverity(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAuditDiagnosticSeverity \n")
+
+	testCases := []struct {
+		providerStrict bool
+		resourceStrict bool
+		expected       diag.Severity
+	}{
+		{false, false, diag.Warning},
+		{true, false, diag.Error},
+		{false, true, diag.Error},
+		{true, true, diag.Error},
+	}
+
+	for _, tc := range testCases {
+		client := &Client{config: &Config{StrictAudit: tc.providerStrict}}
+		d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+			"strict_audit": tc.resourceStrict,
+		})
+
+		severity := auditDiagnosticSeverity(d, client)
+		if severity != tc.expected {
+			t.Errorf("provider strict_audit: %v, resource strict_audit: %v should have returned severity %v. Got: %v", tc.providerStrict, tc.resourceStrict, tc.expected, severity)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// reconnectAttributes Tests
+////////////////////////////////////////////////////////////////
+
+func TestReconnectAttributes(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestReconnectAttributes \n")
+
+	testCases := []struct {
+		resourceType string
+		expected     []string
+		notExpected  []string
+	}{
+		{dsfDataSourceResourceType, []string{"asset_connection", "logs_destination_asset_id", "region", "server_host_name"}, nil},
+		{dsfLogAggregatorResourceType, []string{"asset_connection", "parent_asset_id", "server_host_name"}, []string{"logs_destination_asset_id", "region"}},
+	}
+	for _, tc := range testCases {
+		attributes := reconnectAttributes(tc.resourceType, []interface{}{"admin_email", "asset_connection", "version"})
+
+		for _, attribute := range append(append([]string{}, tc.expected...), "admin_email", "version") {
+			if !contains(attributes, attribute) {
+				t.Errorf("%s: reconnectAttributes should have returned %s. Got: %v", tc.resourceType, attribute, attributes)
+			}
+		}
+		for _, attribute := range tc.notExpected {
+			if contains(attributes, attribute) {
+				t.Errorf("%s: reconnectAttributes should not have returned %s. Got: %v", tc.resourceType, attribute, attributes)
+			}
+		}
+		if len(attributes) != len(reconnectGatewayAttributes[tc.resourceType])+2 {
+			t.Errorf("%s: reconnectAttributes should not have returned duplicate attributes. Got: %v", tc.resourceType, attributes)
+		}
+	}
+}
+
+func TestChangedReconnectAttributes(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestChangedReconnectAttributes \n")
+
+	testCases := []struct {
+		resourceType string
+		resource     *schema.Resource
+		expected     []string
+	}{
+		{dsfDataSourceResourceType, resourceDSFDataSource(), []string{"admin_email", "region"}},
+		{dsfLogAggregatorResourceType, resourceLogAggregator(), []string{"admin_email"}},
+	}
+	for _, tc := range testCases {
+		d := schema.TestResourceDataRaw(t, tc.resource.Schema, map[string]interface{}{
+			"admin_email":         "test@example.com",
+			"reconnect_on_change": []interface{}{"admin_email"},
+			"region":              "us-east-1",
+		})
+
+		changed := changedReconnectAttributes(d, tc.resourceType)
+		if len(changed) != len(tc.expected) {
+			t.Errorf("%s: changedReconnectAttributes should have returned %v. Got: %v", tc.resourceType, tc.expected, changed)
+		}
+		for _, attribute := range tc.expected {
+			if !contains(changed, attribute) {
+				t.Errorf("%s: changedReconnectAttributes should have returned %s. Got: %v", tc.resourceType, attribute, changed)
+			}
+		}
+	}
+}
+
+func TestReconnectGatewayCustomizeDiff(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestReconnectGatewayCustomizeDiff \n")
+
+	stateAttributes := map[string]string{
+		"id":                 "my-mysql-db",
+		"admin_email":        testAdminEmail,
+		"arn":                "arn:aws:rds:us-east-2:123456789012:db:my-mysql-db",
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"audit_pull_enabled": "true",
+		"gateway_id":         "my-gateway",
+		"region":             "us-east-2",
+		"server_host_name":   "my-mysql-db.example.com",
+		"server_type":        "AWS RDS MYSQL",
+	}
+	// the defaults and computed attributes of the state are unchanged
+	for _, attribute := range []string{"adopt_existing", "allow_asset_rename", "deletion_protection", "override_ownership", "strict_audit"} {
+		stateAttributes[attribute] = "false"
+	}
+	for _, attribute := range []string{"availability_zones.#", "available_regions.#", "db_instances_display_name.#", "enabled_logs_exports.#"} {
+		stateAttributes[attribute] = "0"
+	}
+	testCases := []struct {
+		name           string
+		priorReason    string
+		changes        map[string]interface{}
+		planned        bool
+		expectedReason string
+	}{
+		{"reconnect", "", map[string]interface{}{"server_host_name": "other.example.com"}, true, "server_host_name changed: audit collection will be briefly interrupted while the asset is reconnected to gateway"},
+		{"update without reconnect", "", map[string]interface{}{"asset_display_name": "other"}, false, ""},
+		{"no-op plan after the reconnect", "", map[string]interface{}{}, false, ""},
+	}
+	for _, tc := range testCases {
+		attributes := map[string]string{"audit_reconnect_reason": tc.priorReason}
+		config := map[string]interface{}{}
+		for key, value := range stateAttributes {
+			attributes[key] = value
+			if key != "id" && !strings.HasSuffix(key, ".#") {
+				config[key] = value
+			}
+		}
+		config["audit_pull_enabled"] = true
+		for key, value := range tc.changes {
+			config[key] = value
+		}
+		state := &terraform.InstanceState{ID: "my-mysql-db", Attributes: attributes}
+		diff, err := resourceDSFDataSource().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), &Client{config: &Config{}})
+		if err != nil {
+			t.Fatalf("%s: should not have received an error: %s", tc.name, err)
+		}
+		// the reason is cleared by the apply, so an unchanged configuration plans nothing
+		if len(tc.changes) == 0 && diff != nil && !diff.Empty() {
+			t.Errorf("%s: should have planned an empty diff. Got: %#v", tc.name, diff.Attributes)
+		}
+		var reason *terraform.ResourceAttrDiff
+		if diff != nil {
+			reason = diff.Attributes["audit_reconnect_reason"]
+		}
+		switch {
+		case !tc.planned && reason != nil:
+			t.Errorf("%s: should not have changed audit_reconnect_reason. Got: %#v", tc.name, reason)
+		case tc.planned && (reason == nil || reason.New != tc.expectedReason):
+			t.Errorf("%s: should have planned audit_reconnect_reason %q. Got: %#v", tc.name, tc.expectedReason, reason)
+		}
+	}
+}
+
+func TestReconnectOnChangeValidator(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestReconnectOnChangeValidator \n")
+
+	r := resourceLogAggregator()
+	rawConfig, err := ctyjson.Unmarshal([]byte(`{"reconnect_on_change": ["admin_email", "adminemail", "audit_reconnect_reason"]}`), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("Should have parsed the configuration: %s", err)
+	}
+	diags := testValidateRawConfig(r, rawConfig)
+	expected := []struct {
+		summary string
+		path    cty.Path
+	}{
+		{`invalid value "adminemail" for reconnect_on_change, did you mean "admin_email"?`, cty.GetAttrPath("reconnect_on_change").IndexInt(1)},
+		{`invalid value "audit_reconnect_reason" for reconnect_on_change`, cty.GetAttrPath("reconnect_on_change").IndexInt(2)},
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("Should have returned %d errors. Got: %v", len(expected), diags)
+	}
+	for i, e := range expected {
+		if !strings.HasPrefix(diags[i].Summary, e.summary) || !diags[i].AttributePath.Equals(e.path) {
+			t.Errorf("Should have returned %q for %#v. Got: %q for %#v", e.summary, e.path, diags[i].Summary, diags[i].AttributePath)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// requiredFieldsCustomizeDiff Tests
+////////////////////////////////////////////////////////////////
+
+func TestRequiredFieldsCustomizeDiffMissingFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRequiredFieldsCustomizeDiffMissingFields \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_type":        "AWS RDS MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism": "password",
+				"reason":         "default",
+				"username":       "username",
+			},
+		},
+	})
+	// server_host_name is computed, it is missing as it is not set nor derived
+	state := &terraform.InstanceState{RawConfig: testRawConfig(resourceDSFDataSource(), map[string]string{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_type":        "AWS RDS MYSQL",
+	})}
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), state, config, &Client{config: &Config{}})
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	for _, field := range []string{"server_host_name", "password"} {
+		if !strings.Contains(err.Error(), fmt.Sprintf("missing fields: \"%s\"", field)) {
+			t.Errorf("Should have received an error for missing field %s, got: %s", field, err)
+		}
+	}
+}
+
+func TestRequiredFieldsCustomizeDiffUnknownFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRequiredFieldsCustomizeDiffUnknownFields \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"arn":                unknownVariableValue,
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   unknownVariableValue,
+		"server_type":        "AWS RDS MYSQL",
+	})
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	if err != nil {
+		t.Errorf("Should not have received an error for unknown values: %s", err)
+	}
+}
+
+func TestRequiredFieldsCustomizeDiffUnsupportedAuthMechanism(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRequiredFieldsCustomizeDiffUnsupportedAuthMechanism \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"arn":                "arn:aws:rds:us-east-2:123456789012:db:my-mysql-db",
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "my-mysql-db.example.com",
+		"server_type":        "AWS RDS MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism": "kerberos",
+				"reason":         "default",
+			},
+		},
+	})
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "unsupported authMechanism 'kerberos' for serverType 'AWS RDS MYSQL'") {
+		t.Errorf("Should have received unsupported authMechanism error, got: %s", err)
+	}
+}
+
+// testValidateRawConfig returns the diagnostics of the configuration
+// validators of a resource
+func testValidateRawConfig(r *schema.Resource, rawConfig cty.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, validate := range r.ValidateRawResourceConfigFuncs {
+		resp := &schema.ValidateResourceConfigFuncResponse{}
+		validate(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: rawConfig}, resp)
+		diags = append(diags, resp.Diagnostics...)
+	}
+	return diags
+}
+
+func TestRequiredFieldsValidator(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRequiredFieldsValidator \n")
+
+	r := resourceDSFDataSource()
+	rawConfig, err := ctyjson.Unmarshal([]byte(`{
+		"admin_email": "`+testAdminEmail+`",
+		"asset_display_name": "my-mysql-db",
+		"asset_id": "my-mysql-db",
+		"gateway_id": "my-gateway",
+		"server_type": "AWS RDS MYSQL",
+		"asset_connection": [{"auth_mechanism": "password", "reason": "default", "username": "username"}]
+	}`), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("Should have parsed the configuration: %s", err)
+	}
+
+	// the required fields are not checked before the provider is configured
+	if diags := testValidateRawConfig(r, rawConfig); len(diags) != 0 {
+		t.Errorf("Should not have validated the configuration before the provider is configured. Got: %v", diags)
+	}
+
+	r = testConfiguredResource(dsfDataSourceResourceType, &Client{config: &Config{}})
+	diags := testValidateRawConfig(r, rawConfig)
+	expected := map[string]cty.Path{
+		"server_host_name": cty.GetAttrPath("server_host_name"),
+		"password":         cty.GetAttrPath("asset_connection").IndexInt(0).GetAttr("password"),
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("Should have returned one diagnostic per missing field. Got: %v", diags)
+	}
+	for _, d := range diags {
+		var field string
+		for name := range expected {
+			if strings.HasSuffix(d.Summary, fmt.Sprintf("missing fields: \"%s\"", name)) {
+				field = name
+			}
+		}
+		if field == "" || !strings.HasPrefix(d.Summary, "missing required fields for dsfhub_data_source with serverType 'AWS RDS MYSQL'") {
+			t.Errorf("Unexpected diagnostic: %s", d.Summary)
+			continue
+		}
+		if !d.AttributePath.Equals(expected[field]) {
+			t.Errorf("The diagnostic for %s should be attached to %#v. Got: %#v", field, expected[field], d.AttributePath)
+		}
+	}
+}
+
+func TestRequiredFieldsValidatorUnknownFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestRequiredFieldsValidatorUnknownFields \n")
+
+	r := testConfiguredResource(dsfLogAggregatorResourceType, &Client{config: &Config{}})
+	rawConfig := testRawConfig(r, map[string]string{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-log-group",
+		"gateway_id":         "my-gateway",
+		"server_type":        "AWS LOG GROUP",
+	})
+	// asset_id is not known yet, parent_asset_id is missing
+	attrs := rawConfig.AsValueMap()
+	attrs["asset_id"] = cty.UnknownVal(cty.String)
+	rawConfig = cty.ObjectVal(attrs)
+
+	diags := testValidateRawConfig(r, rawConfig)
+	expected := "missing required fields for dsfhub_log_aggregator with serverType 'AWS LOG GROUP', missing fields: \"parent_asset_id\""
+	if len(diags) != 1 || diags[0].Summary != expected || !diags[0].AttributePath.Equals(cty.GetAttrPath("parent_asset_id")) {
+		t.Errorf("Should only have reported %q. Got: %v", expected, diags)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// unused connection field Tests
+////////////////////////////////////////////////////////////////
+
+func TestFindUnusedConnectionFields(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestFindUnusedConnectionFields \n")
+
+	d := schema.TestResourceDataRaw(t, resourceDSFDataSource().Schema, map[string]interface{}{
+		"server_type": "AWS RDS MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism": "password",
+				"database_name":  "mydb",
+				"keytab_file":    "/etc/krb5.keytab",
+				"password":       "password",
+				"reason":         "default",
+				"username":       "username",
+			},
+		},
+	})
+
+	unusedFields := findUnusedConnectionFields(embeddedAssetDefinitions, dsfDataSourceResourceType, d)
+	if len(unusedFields) != 1 {
+		t.Fatalf("Should have found 1 unused field. Got: %v", unusedFields)
+	}
+	if unusedFields[0].Field != "keytab_file" || unusedFields[0].AuthMechanism != "password" {
+		t.Errorf("Should have found unused field keytab_file for auth_mechanism password. Got: %v", unusedFields[0])
+	}
+
+	// the fields allowed by a server type override the fields allowed for all
+	// server types
+	requiredFieldsJson := `{"ServerTypes": {"AWS RDS MYSQL": {"allowed_auth_mechanisms": {"password": ["keytab_file", "password"]}, "auth_mechanisms": {"password": ["reason", "username", "password"]}, "required": []}}}`
+	definitions := &assetDefinitions{
+		authMechanismAllowedFieldsJson: authMechanismAllowedFieldsJson,
+		requiredFieldsJson:             map[string]string{dsfDataSourceResourceType: requiredFieldsJson},
+	}
+	if unusedFields := findUnusedConnectionFields(definitions, dsfDataSourceResourceType, d); len(unusedFields) != 0 {
+		t.Errorf("Should not have found unused fields allowed for the server type. Got: %v", unusedFields)
+	}
+}
+
+func TestUnusedConnectionFieldsValidator(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestUnusedConnectionFieldsValidator \n")
+
+	r := resourceDSFDataSource()
+	rawConfig, err := ctyjson.Unmarshal([]byte(`{
+		"server_type": "AWS RDS MYSQL",
+		"asset_connection": [
+			{"auth_mechanism": "password", "password": "password", "reason": "default", "username": "username"},
+			{"auth_mechanism": "password", "keytab_file": "/etc/krb5.keytab", "password": "password", "reason": "sonar", "username": "username"}
+		]
+	}`), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("Should have parsed the configuration: %s", err)
+	}
+
+	testCases := []struct {
+		name     string
+		meta     interface{}
+		warnings int
+	}{
+		{"not configured", nil, 0},
+		{"warn", &Client{config: &Config{UnusedConnectionFields: "warn"}}, 1},
+		{"error", &Client{config: &Config{UnusedConnectionFields: "error"}}, 0},
+		{"ignore", &Client{config: &Config{UnusedConnectionFields: "ignore"}}, 0},
+	}
+	for _, tc := range testCases {
+		validator := unusedConnectionFieldsValidator(dsfDataSourceResourceType, r.Schema, func() interface{} { return tc.meta })
+		resp := &schema.ValidateResourceConfigFuncResponse{}
+		validator(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: rawConfig}, resp)
+		if len(resp.Diagnostics) != tc.warnings {
+			t.Errorf("%s: should have returned %d warnings. Got: %v", tc.name, tc.warnings, resp.Diagnostics)
+			continue
+		}
+		if tc.warnings == 0 {
+			continue
+		}
+		warning := resp.Diagnostics[0]
+		expectedPath := cty.GetAttrPath("asset_connection").IndexInt(1).GetAttr("keytab_file")
+		if warning.Severity != diag.Warning || !warning.AttributePath.Equals(expectedPath) {
+			t.Errorf("%s: should have returned a warning for asset_connection[1].keytab_file. Got: %v", tc.name, warning)
+		}
+	}
+}
+
+func TestUnusedConnectionFieldsCustomizeDiff(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestUnusedConnectionFieldsCustomizeDiff \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"arn":                "arn:aws:rds:us-east-2:123456789012:db:my-mysql-db",
+		"asset_display_name": "my-mysql-db",
+		"asset_id":           "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "my-mysql-db.example.com",
+		"server_type":        "AWS RDS MYSQL",
+		"asset_connection": []interface{}{
+			map[string]interface{}{
+				"auth_mechanism": "password",
+				"password":       "password",
+				"reason":         "default",
+				"secret_key":     "secret",
+				"username":       "username",
+			},
+		},
+	})
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{UnusedConnectionFields: "warn"}})
+	if err != nil {
+		t.Errorf("Should not have received an error when unused_connection_fields is warn: %s", err)
+	}
+
+	_, err = resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{UnusedConnectionFields: "error"}})
+	if err == nil {
+		t.Fatalf("Should have received an error when unused_connection_fields is error")
+	}
+	if !strings.Contains(err.Error(), "asset_connection field \"secret_key\" has no effect for serverType 'AWS RDS MYSQL' with auth_mechanism 'password'") {
+		t.Errorf("Should have received an unused field error, got: %s", err)
+	}
+}
@@ -5,4 +5,7 @@ const (
 	dsfLogAggregatorResourceType = "dsfhub_log_aggregator"
 	dsfCloudAccountResourceType  = "dsfhub_cloud_account"
 	dsfSecretManagerResourceType = "dsfhub_secret_manager"
+
+	dsfAssetOperationResourceType  = "dsfhub_asset_operation"
+	dsfAuditCollectionResourceType = "dsfhub_audit_collection"
 )
@@ -1,8 +1,10 @@
 package dsfhub
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 	"time"
 
@@ -1297,6 +1299,97 @@ func TestAccDSFDataSource_GcpPostgresql(t *testing.T) {
 	})
 }
 
+func TestDataSourceAssetIdCustomizeDiffDerivesAssetId(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestDataSourceAssetIdCustomizeDiffDerivesAssetId \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-oracle-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "mydbhost",
+		"server_ip":          "10.0.0.1",
+		"server_port":        "1521",
+		"server_type":        "ORACLE",
+		"service_name":       "ORCL",
+	})
+
+	diff, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if diff.Attributes["asset_id"] == nil || diff.Attributes["asset_id"].New != "mydbhost:ORACLE:ORCL:1521" {
+		t.Errorf("Should have derived asset_id mydbhost:ORACLE:ORCL:1521. Got: %v", diff.Attributes["asset_id"])
+	}
+}
+
+func TestDataSourceAssetIdCustomizeDiffForcesNew(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestDataSourceAssetIdCustomizeDiffForcesNew \n")
+
+	state := &terraform.InstanceState{
+		ID: "mydbhost:MYSQL::3306",
+		Attributes: map[string]string{
+			"admin_email":        testAdminEmail,
+			"asset_display_name": "my-mysql-db",
+			"asset_id":           "mydbhost:MYSQL::3306",
+			"gateway_id":         "my-gateway",
+			"id":                 "mydbhost:MYSQL::3306",
+			"server_host_name":   "mydbhost",
+			"server_ip":          "10.0.0.1",
+			"server_port":        "3306",
+			"server_type":        "MYSQL",
+		},
+	}
+	configValues := map[string]string{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "mydbhost",
+		"server_ip":          "10.0.0.1",
+		"server_port":        "3307",
+		"server_type":        "MYSQL",
+	}
+	rawConfig := map[string]interface{}{}
+	for k, v := range configValues {
+		rawConfig[k] = v
+	}
+	config := terraform.NewResourceConfigRaw(rawConfig)
+	state.RawConfig = testRawConfig(resourceDSFDataSource(), configValues)
+
+	diff, err := resourceDSFDataSource().SimpleDiff(context.Background(), state, config, &Client{config: &Config{}})
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+	if diff.Attributes["asset_id"] == nil || diff.Attributes["asset_id"].New != "mydbhost:MYSQL::3307" {
+		t.Errorf("Should have derived asset_id mydbhost:MYSQL::3307. Got: %v", diff.Attributes["asset_id"])
+	}
+	if !diff.RequiresNew() {
+		t.Errorf("Should have required replacing the data source")
+	}
+}
+
+func TestDataSourceAssetIdCustomizeDiffCloudServerType(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestDataSourceAssetIdCustomizeDiffCloudServerType \n")
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-mysql-db",
+		"gateway_id":         "my-gateway",
+		"server_host_name":   "my-db.abcdefg.us-east-2.rds.amazonaws.com",
+		"server_type":        "AWS RDS MYSQL",
+	})
+
+	_, err := resourceDSFDataSource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.Contains(err.Error(), "asset_id must be set for serverType 'AWS RDS MYSQL'") {
+		t.Errorf("Should have received an error for the missing asset_id, got: %s", err)
+	}
+}
+
 func testAccDSFDataSourceId(state *terraform.State) (string, error) {
 	log.Printf("[INFO] Running test testAccDSFDataSourceId \n")
 	for _, rs := range state.RootModule().Resources {
@@ -15,16 +15,14 @@ resource "%[1]s" "%[2]s" {
 }`, dsfDataSourceResourceType, resourceName, adminEmail, assetId, gatewayId, serverHostName, serverType)
 }
 
-var commonBasicConnectionPassword = fmt.Sprintf(`
+const commonBasicConnectionPassword = `
   asset_connection {
     auth_mechanism = "password"
     password       = "password"
     reason         = "default"
     username       = "username"
   }
-  
-  %[1]s
-`, ignoreAssetConnectionChangesBlock())
+`
 
 // Output a terraform config for an AWS DOCUMENTDB CLUSTER data source resource.
 func testAccDSFDataSourceConfig_AwsDocumentdbCluster(resourceName string, gatewayId string, assetId string, auditPullEnabled string) string {
@@ -73,16 +71,14 @@ const awsDynamodbConnectionIamRole = `
   }
 `
 
-var awsDynamodbConnectionKey = fmt.Sprintf(`
+const awsDynamodbConnectionKey = `
   asset_connection {
     access_id       = "my-access-id"
     auth_mechanism  = "key"
     reason          = "default"
     secret_key      = "my-secret-key"
   }
-
-  %[1]s
-`, ignoreAssetConnectionChangesBlock())
+`
 
 const awsDynamodbConnectionProfile = `
   asset_connection {
@@ -447,7 +443,7 @@ resource "%[1]s" "%[2]s" {
 		gatewayId)
 }
 
-var AwsRedshiftConnectionPassword = fmt.Sprintf(`
+const AwsRedshiftConnectionPassword = `
   asset_connection {
     auth_mechanism = "password"
     database_name  = "dev"
@@ -455,9 +451,7 @@ var AwsRedshiftConnectionPassword = fmt.Sprintf(`
     reason         = "default"
     username       = "username"
   }
-
-  %[1]s
-`, ignoreAssetConnectionChangesBlock())
+`
 
 // Output a terraform config for an AWS REDSHIFT data source resource.
 func testAccDSFDataSourceConfig_AwsRedshift(resourceName string, gatewayId string, assetId string, auditType string, auditPullEnabled string, logsDestinationAssetId string) string {
@@ -0,0 +1,220 @@
+package dsfhub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// openEnumFields lists fields whose values in the asset schema are examples
+// rather than the full list of values accepted by the hub
+var openEnumFields = map[string]bool{
+	// any reason can be used to differentiate the connections of an asset
+	"reason": true,
+}
+
+// serverTypeEnumFields are the fields whose values the hub restricts per
+// server type. Their values are listed in allowed_values of each server type
+// rather than in the asset schema, see serverTypeEnumCustomizeDiff.
+var serverTypeEnumFields = []string{"audit_data_type", "audit_type"}
+
+// assetSchemaEnumValues maps the id of each enumerated field of the embedded
+// asset schema to its allowed values
+var assetSchemaEnumValues = parseAssetSchemaEnumValues(assetSchemaJson)
+
+// parseAssetSchemaEnumValues returns the allowed values of every field in the
+// asset schema that lists values, except for openEnumFields and
+// serverTypeEnumFields
+func parseAssetSchemaEnumValues(assetSchemaJson string) map[string][]string {
+	var assetSchema AssetSchema
+	err := json.Unmarshal([]byte(assetSchemaJson), &assetSchema)
+	if err != nil {
+		log.Printf("[DEBUG] json.Unmarshal([]byte(assetSchemaJson), &assetSchema) %s:\n", err)
+		panic(err)
+	}
+
+	enumValues := map[string][]string{}
+	for _, fields := range []map[string]SchemaField{assetSchema.Details, assetSchema.Connections} {
+		for _, field := range fields {
+			values, ok := field.Values.([]interface{})
+			if !ok || len(values) == 0 || openEnumFields[field.ID] || contains(serverTypeEnumFields, field.ID) {
+				continue
+			}
+			for _, value := range values {
+				enumValues[field.ID] = append(enumValues[field.ID], fmt.Sprintf("%v", value))
+			}
+		}
+	}
+	return enumValues
+}
+
+// assetSchemaEnumCustomizeDiff fails the plan when server_type is not a
+// server type of the resource type, or a field is set to a value that is not
+// listed for it in the asset schema, including the fields of asset_connection
+// blocks. Both are checked against the asset
+// definitions of the provider configuration, which may add server types and
+// values to the embedded ones. Values that are not known yet are checked when
+// the plan is run again at apply time.
+func assetSchemaEnumCustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		definitions := m.(*Client).assetDefinitions()
+		var errs []error
+		checkEnumValue := func(field string, value interface{}, allowed []string, path cty.Path) {
+			if isEmptyAttributeValue(value) || value == unknownVariableValue {
+				return
+			}
+			for _, diagnostic := range enumValueDiagnostics(field, fmt.Sprintf("%v", value), allowed, path) {
+				errs = append(errs, path.NewErrorf("%s (%s)", diagnostic.Summary, diagnostic.Detail))
+			}
+		}
+
+		fields := make([]string, 0, len(definitions.enumValues))
+		for field := range definitions.enumValues {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range append([]string{"server_type"}, fields...) {
+			if !d.NewValueKnown(field) {
+				continue
+			}
+			allowed := definitions.enumValues[field]
+			if field == "server_type" {
+				allowed = requiredFieldsServerTypes(definitions.requiredFieldsJson[resourceType])
+			}
+			value, _ := d.GetOk(field)
+			checkEnumValue(field, value, allowed, cty.GetAttrPath(field))
+		}
+		if !d.NewValueKnown("asset_connection") {
+			return errors.Join(errs...)
+		}
+		connections, _ := d.Get("asset_connection").([]interface{})
+		for i, conn := range connections {
+			connection, _ := conn.(map[string]interface{})
+			for _, field := range fields {
+				checkEnumValue(field, connection[field], definitions.enumValues[field], cty.GetAttrPath("asset_connection").IndexInt(i).GetAttr(field))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// serverTypeEnumCustomizeDiff fails the plan when a field of
+// serverTypeEnumFields is set to a value that is not allowed for the server
+// type of the asset. Server types that list no allowed values for a field
+// accept any value.
+func serverTypeEnumCustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown("server_type") {
+			return nil
+		}
+		serverType := d.Get("server_type").(string)
+		allowedValues := requiredFieldsAllowedValues(m.(*Client).assetDefinitions().requiredFieldsJson[resourceType], serverType)
+
+		var errs []error
+		for _, field := range serverTypeEnumFields {
+			allowed := allowedValues[field]
+			if len(allowed) == 0 || !d.NewValueKnown(field) {
+				continue
+			}
+			value, _ := d.Get(field).(string)
+			for _, diagnostic := range enumValueDiagnostics(field, value, allowed, cty.GetAttrPath(field)) {
+				errs = append(errs, cty.GetAttrPath(field).NewErrorf("%s (%s for serverType '%s')", diagnostic.Summary, diagnostic.Detail, serverType))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// requiredFieldsAllowedValues returns the allowed values of the fields
+// restricted by a server type in the required fields json of a resource
+func requiredFieldsAllowedValues(requiredFieldsJson string, serverType string) map[string][]string {
+	var requiredFields RequiredFieldsMap
+	err := json.Unmarshal([]byte(requiredFieldsJson), &requiredFields)
+	if err != nil {
+		log.Printf("[DEBUG] json.Unmarshal([]byte(requiredFieldsJson), &requiredFields) %s:\n", err)
+		panic(err)
+	}
+	return requiredFields.ServerType[serverType].AllowedValues
+}
+
+// requiredFieldsServerTypes returns the sorted server types listed in the
+// required fields json of a resource
+func requiredFieldsServerTypes(requiredFieldsJson string) []string {
+	var requiredFields RequiredFieldsMap
+	err := json.Unmarshal([]byte(requiredFieldsJson), &requiredFields)
+	if err != nil {
+		log.Printf("[DEBUG] json.Unmarshal([]byte(requiredFieldsJson), &requiredFields) %s:\n", err)
+		panic(err)
+	}
+
+	serverTypes := make([]string, 0, len(requiredFields.ServerType))
+	for serverType := range requiredFields.ServerType {
+		serverTypes = append(serverTypes, serverType)
+	}
+	sort.Strings(serverTypes)
+	return serverTypes
+}
+
+// enumValueDiagnostics returns an error diagnostic if value is not one of the
+// allowed values, suggesting the closest allowed value if there is one
+func enumValueDiagnostics(field string, value string, allowed []string, path cty.Path) diag.Diagnostics {
+	if value == "" || len(allowed) == 0 || contains(allowed, value) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("invalid value %q for %s%s", value, field, didYouMean(value, allowed)),
+		Detail:        fmt.Sprintf("expected %s to be one of: \"%s\"", field, strings.Join(allowed, "\", \"")),
+		AttributePath: path,
+	}}
+}
+
+// didYouMean returns a suggestion for the allowed value closest to value, or
+// an empty string if none of them is close enough to be a likely typo
+func didYouMean(value string, allowed []string) string {
+	suggestion := ""
+	bestDistance := len(value)/3 + 1
+	for _, candidate := range allowed {
+		distance := levenshteinDistance(strings.ToLower(value), strings.ToLower(candidate))
+		if distance < bestDistance {
+			suggestion = candidate
+			bestDistance = distance
+		}
+	}
+	if suggestion == "" {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %q?", suggestion)
+}
+
+// levenshteinDistance returns the number of single character edits needed to
+// turn a into b
+func levenshteinDistance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
@@ -0,0 +1,137 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAssetSchemaEnumValues(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetSchemaEnumValues \n")
+
+	for _, field := range []string{"criticality", "format", "pull_type", "used_for"} {
+		if len(assetSchemaEnumValues[field]) == 0 {
+			t.Errorf("Should have parsed values for %s from the asset schema", field)
+		}
+	}
+	for field := range openEnumFields {
+		if _, found := assetSchemaEnumValues[field]; found {
+			t.Errorf("Should not have parsed values for open enum field %s. Got: %v", field, assetSchemaEnumValues[field])
+		}
+	}
+	for _, field := range serverTypeEnumFields {
+		if _, found := assetSchemaEnumValues[field]; found {
+			t.Errorf("Should not have parsed values for %s, which are allowed per server type. Got: %v", field, assetSchemaEnumValues[field])
+		}
+	}
+}
+
+func TestServerTypeEnumCustomizeDiff(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestServerTypeEnumCustomizeDiff \n")
+
+	testCases := []struct {
+		serverType string
+		auditType  string
+		expected   string
+	}{
+		{"AWS KINESIS", "KINESIS_AGGREGATED", ""},
+		{"AWS KINESIS", "KINESES", `invalid value "KINESES" for audit_type, did you mean "KINESIS"? (expected audit_type to be one of: "KINESIS", "KINESIS_AGGREGATED" for serverType 'AWS KINESIS')`},
+		{"GCP PUBSUB", "LOG_GROUP", `invalid value "LOG_GROUP" for audit_type`},
+		{"AWS LOG GROUP", "AWS_RDS_MYSQL_SLOW", ""},
+		// server types that list no audit types accept any value
+		{"AZURE EVENTHUB", "ANY_AUDIT_TYPE", ""},
+	}
+	for _, tc := range testCases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"admin_email":        testAdminEmail,
+			"asset_display_name": "my-log-aggregator",
+			"asset_id":           "my-log-aggregator",
+			"audit_type":         tc.auditType,
+			"gateway_id":         "my-gateway",
+			"server_type":        tc.serverType,
+		})
+		_, err := resourceLogAggregator().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+		switch {
+		case tc.expected == "" && err != nil && strings.Contains(err.Error(), "for audit_type"):
+			t.Errorf("audit_type %s should be allowed for %s. Got: %s", tc.auditType, tc.serverType, err)
+		case tc.expected != "" && (err == nil || !strings.Contains(err.Error(), tc.expected)):
+			t.Errorf("audit_type %s should not be allowed for %s, expected %q. Got: %v", tc.auditType, tc.serverType, tc.expected, err)
+		}
+	}
+}
+
+func TestAssetSchemaEnumCustomizeDiff(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetSchemaEnumCustomizeDiff \n")
+
+	testCases := []struct {
+		resource   func() *schema.Resource
+		serverType string
+		field      string
+		value      interface{}
+		expected   string
+	}{
+		{resourceDSFDataSource, "AWS RDS MYSQL", "used_for", "Production", ""},
+		{resourceDSFDataSource, "AWS RDS MYSQL", "used_for", "", ""},
+		{resourceDSFDataSource, "AWS RDS MYSQL", "criticality", 2, ""},
+		{resourceDSFDataSource, "AWS RDS MYSQL", "criticality", 5, "invalid value \"5\" for criticality"},
+		{resourceDSFDataSource, "AWS RDS MYSQL", "used_for", "Prodution", "invalid value \"Prodution\" for used_for, did you mean \"Production\"?"},
+		{resourceDSFDataSource, "AWS RDS MYSLQ", "used_for", "Production", "invalid value \"AWS RDS MYSLQ\" for server_type, did you mean \"AWS RDS MYSQL\"?"},
+		{resourceLogAggregator, "ALIBABA LOGSTORE", "pull_type", "something_else", "invalid value \"something_else\" for pull_type"},
+		{resourceCloudAccount, "AWZ", "asset_display_name", "my-account", "invalid value \"AWZ\" for server_type, did you mean \"AWS\"?"},
+	}
+
+	for _, tc := range testCases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"admin_email":        testAdminEmail,
+			"asset_display_name": "my-asset",
+			"asset_id":           "my-asset",
+			"gateway_id":         "my-gateway",
+			"server_type":        tc.serverType,
+			tc.field:             tc.value,
+		})
+		_, err := tc.resource().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}})
+		switch {
+		case tc.expected == "" && err != nil && strings.Contains(err.Error(), "invalid value"):
+			t.Errorf("%s = %v should have been valid. Got: %s", tc.field, tc.value, err)
+		case tc.expected != "" && (err == nil || !strings.Contains(err.Error(), tc.expected)):
+			t.Errorf("%s = %v should have returned %q. Got: %v", tc.field, tc.value, tc.expected, err)
+		}
+	}
+
+	// the fields of asset_connection blocks are checked as well
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"admin_email":        testAdminEmail,
+		"asset_display_name": "my-eventhub",
+		"asset_id":           "my-eventhub",
+		"gateway_id":         "my-gateway",
+		"server_type":        "AZURE EVENTHUB",
+		"asset_connection": []interface{}{
+			map[string]interface{}{"auth_mechanism": "default", "format": "postgresql", "reason": "default"},
+		},
+	})
+	expected := "invalid value \"postgresql\" for format, did you mean \"Postgresql\"?"
+	if _, err := resourceLogAggregator().SimpleDiff(context.Background(), nil, config, &Client{config: &Config{}}); err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("Should have returned %q. Got: %v", expected, err)
+	}
+}
+
+func TestDidYouMean(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestDidYouMean \n")
+
+	allowed := []string{"kerberos", "password", "iam_role"}
+
+	if suggestion := didYouMean("pasword", allowed); suggestion != ", did you mean \"password\"?" {
+		t.Errorf("Should have suggested password. Got: %q", suggestion)
+	}
+	if suggestion := didYouMean("oauth", allowed); suggestion != "" {
+		t.Errorf("Should not have suggested a value for oauth. Got: %q", suggestion)
+	}
+}
@@ -27,7 +27,7 @@ func TestAccDSFLogAggregator_AwsKinesis(t *testing.T) {
 			// Failed: bad audit_type
 			{
 				Config:      testAccDSFLogAggregatorConfig_AwsKinesis(resourceName, gatewayId, assetId, "", false, "BAD_AUDIT_TYPE"),
-				ExpectError: regexp.MustCompile("Asset attribute options mismatch: the value 'BAD_AUDIT_TYPE' for field 'audit_type' is invalid"),
+				ExpectError: regexp.MustCompile("invalid value \"BAD_AUDIT_TYPE\" for audit_type"),
 			},
 			// Test various audit types
 			{Config: testAccDSFLogAggregatorConfig_AwsKinesis(resourceName, gatewayId, assetId, "", false, "KINESIS")},
@@ -63,7 +63,7 @@ func TestAccDSFLogAggregator_AwsLogGroup(t *testing.T) {
 			// Failed: missing parent_asset_id
 			{
 				Config:      testAccDSFLogAggregatorConfig_AwsLogGroup(resourceName, gatewayId, assetId, "", true, "LOG_GROUP", ""),
-				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_data_source"),
+				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_log_aggregator"),
 			},
 			// Onboard with AWS parent asset
 			{
@@ -133,7 +133,7 @@ func TestAccDSFLogAggregator_AzureEventhub(t *testing.T) {
 			// Failed: missing format
 			{
 				Config:      testAccDSFLogAggregatorConfig_AzureEventhub(resourceName, gatewayId, assetId, "default", "", "true", "", ""),
-				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_data_source with serverType 'AZURE EVENTHUB', missing fields: \"format\""),
+				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_log_aggregator with serverType 'AZURE EVENTHUB', missing fields: \"format\""),
 			},
 			// Failed: invalid format
 			{
@@ -171,7 +171,7 @@ func TestAccDSFLogAggregator_GcpCloudStorageBucket(t *testing.T) {
 			// Failed: missing asset_display_name, asset_id, pubsub_subscription
 			{
 				Config:      testAccDSFLogAggregatorConfig_GcpCloudStorageBucket(resourceName, gatewayId, "", "", "false", ""),
-				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_data_source"),
+				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_log_aggregator"),
 			},
 			// Onboard and connect/disconnect to gateway as standalone log aggregator
 			{Config: testAccDSFLogAggregatorConfig_GcpCloudStorageBucket(resourceName, gatewayId, assetId, "", "true", "GCP MS SQL SERVER")},
@@ -203,7 +203,7 @@ func TestAccDSFLogAggregator_GcpPubsubBasic(t *testing.T) {
 			// Failed: missing asset_display_name, asset_id, pubsub_subscription
 			{
 				Config:      testAccDSFLogAggregatorConfig_GcpPubsub(resourceName, gatewayId, "", "default", "", "false", "", ""),
-				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_data_source"),
+				ExpectError: regexp.MustCompile("Error: missing required fields for dsfhub_log_aggregator"),
 			},
 			// Test different auth_mechanisms
 			{
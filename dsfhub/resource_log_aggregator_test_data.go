@@ -130,10 +130,7 @@ func azureEventhubConnectionBlock(authMechanism string, format string) string {
     format                  = "%[1]s"
     subscription_id         = "a1b2c3de-123c-1234-ab12-ab12c2de3fg4"
     reason                  = "default"
-  }
-  
-  %[2]s
-  `, format, ignoreAssetConnectionChangesBlock())
+  }`, format)
 	case "default":
 		output = fmt.Sprintf(`
   asset_connection {
@@ -147,10 +144,7 @@ func azureEventhubConnectionBlock(authMechanism string, format string) string {
     eventhub_namespace       = "myeventhubnamespace"
     format                   = "%[1]s"
     reason                   = "default"
-  }
-  
-  %[2]s
-  `, format, ignoreAssetConnectionChangesBlock())
+  }`, format)
 	}
 
 	return output
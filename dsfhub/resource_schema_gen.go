@@ -0,0 +1,2667 @@
+// Code generated by internal/generate/assetschema from asset_schema.json; DO NOT EDIT.
+
+package dsfhub
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceDSFDataSourceSchema returns the attributes of dsfhub_data_source
+func resourceDSFDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"admin_email": {
+			Type:        schema.TypeString,
+			Description: "The email address to notify about this asset",
+			Required:    true,
+		},
+		"adopt_existing": {
+			Type:        schema.TypeBool,
+			Description: "If true, creating the resource adopts an existing asset with the same asset_id instead of failing: the asset is updated to match the configuration and managed by Terraform from then on. Adoption is also enabled for all resources by adopt_existing on the provider. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"allow_asset_rename": {
+			Type:        schema.TypeBool,
+			Description: "If true, changing asset_id recreates the asset with dependent repointing instead of replacing it: the asset is created with the new asset_id, the assets referencing the old asset_id, e.g. in parent_asset_id or secret_asset_id, are pointed at the new one, and the old asset is deleted. The rename fails if one of them is owned by another workspace, and is rolled back if a step fails before the old asset is deleted. This is not an in-place rename: the audit history and gateway state of the old asset are not carried over to the new one. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"application": {
+			Type:        schema.TypeString,
+			Description: "The Asset ID of the application asset that \"owns\" the asset.",
+			Optional:    true,
+		},
+		"archive": {
+			Type:        schema.TypeBool,
+			Description: "If True archive files in the asset after being processed by sonargd. Defaults to True if field isn't present",
+			Optional:    true,
+			Computed:    true,
+		},
+		"arn": {
+			Type:        schema.TypeString,
+			Description: "Amazon Resource Name - format is arn:partition:service:region:account-id:resource-type:resource-id and used as the asset_id",
+			Optional:    true,
+			Computed:    true,
+		},
+		"asset_checksum": {
+			Type:        schema.TypeString,
+			Description: "Checksum of the asset as last read from the DSF Hub, used to detect changes made outside of Terraform when update_conflict_check is enabled on the provider",
+			Computed:    true,
+		},
+		"asset_connection": {
+			Type:        schema.TypeList,
+			Description: "N/A",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"access_id": {
+						Type:        schema.TypeString,
+						Description: "The Account Name/Access ID to use when authenticating to Snowflake",
+						Optional:    true,
+						Computed:    true,
+					},
+					"access_key": {
+						Type:             schema.TypeString,
+						Description:      "The Secret access key used for authentication",
+						Optional:         true,
+						Computed:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"account_name": {
+						Type:        schema.TypeString,
+						Description: "The cloudant account name required when connecting a resource with IAM role",
+						Optional:    true,
+					},
+					"amazon_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with AWS Secrets Manager",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataAmazonSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for AWS secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "AWS secret asset id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "AWS secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"api_key": {
+						Type:             schema.TypeString,
+						Description:      "IAM authentication API key",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"auth_mechanism": {
+						Type:         schema.TypeString,
+						Description:  "Specifies the auth mechanism used by the connection",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"aws_credentials", "default", "ec2", "iam_role", "kerberos", "key_file", "key", "oauth-azure-ad", "oauth", "oauth2", "oracle_wallet", "password", "profile", "service_account", "ssl"}, false),
+					},
+					"autocommit": {
+						Type:        schema.TypeBool,
+						Description: "If true, Commit automatically don't wait for transaction to be explicitly committed",
+						Optional:    true,
+						Computed:    true,
+					},
+					"aws_connection_id": {
+						Type:        schema.TypeString,
+						Description: "The parent AWS connection document_id",
+						Optional:    true,
+					},
+					"bucket": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"ca_certs_path": {
+						Type:        schema.TypeString,
+						Description: "Certificate authority certificates path; what location should the sysetm look for certificate information from. Equivalent to --capath in a curl call",
+						Optional:    true,
+					},
+					"ca_file": {
+						Type:        schema.TypeString,
+						Description: "Use the specified certificate file to verify the peer. The file may contain multiple CA certificates.",
+						Optional:    true,
+					},
+					"cache_file": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"cert_file": {
+						Type:        schema.TypeString,
+						Description: "Use the specified client certificate file when getting a file with HTTPS, FTPS or another SSL-based protocol.",
+						Optional:    true,
+					},
+					"client_id": {
+						Type:        schema.TypeString,
+						Description: "Azure client application ID",
+						Optional:    true,
+					},
+					"client_secret": {
+						Type:             schema.TypeString,
+						Description:      "Azure application client secret",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"cluster_id": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"cluster_member_id": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"cluster_name": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"content_type": {
+						Type:        schema.TypeString,
+						Description: "Content-Type to append to the HTTP headers in the curl call",
+						Optional:    true,
+					},
+					"crn": {
+						Type:        schema.TypeString,
+						Description: "The CRN unique identifier of the resource",
+						Optional:    true,
+					},
+					"cyberark_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with CyberArk Secrets Manager",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataCyberarkSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for CyberArk secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"database_name": {
+						Type:        schema.TypeString,
+						Description: "Specifies the name of the database (or default DB) to connect to.",
+						Optional:    true,
+					},
+					"db_role": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"dn": {
+						Type:        schema.TypeString,
+						Description: "The distinguished name of a particular PKI certificate",
+						Optional:    true,
+					},
+					"dns_srv": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+						Computed:    true,
+					},
+					"driver": {
+						Type:        schema.TypeString,
+						Description: "A path to a non-default driver location. If populated this driver will be used rather than the default",
+						Optional:    true,
+					},
+					"dsn": {
+						Type:        schema.TypeString,
+						Description: "Data Source Name",
+						Optional:    true,
+					},
+					"external": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+						Computed:    true,
+					},
+					"external_id": {
+						Type:        schema.TypeString,
+						Description: "External ID to use when assuming a role",
+						Optional:    true,
+					},
+					"extra_connection_data": {
+						Type:             schema.TypeString,
+						Description:      "JSON object of connectionData fields that the provider does not model, merged into the connection sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+						Optional:         true,
+						ValidateDiagFunc: validateJsonObject,
+					},
+					"extra_kinit_parameters": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"hashicorp_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with HashiCorp Vault",
+						Optional:    true,
+						Set:         resourceConnectionDataHashicorpSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for HashiCorp secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"path": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret path",
+									Optional:    true,
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"hive_server_type": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"host_name_mismatch": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+						Computed:    true,
+					},
+					"hosts": {
+						Type:        schema.TypeString,
+						Description: "Required for quering the logdna url. cloudantnosqldb in the case of a cloudant DB",
+						Optional:    true,
+					},
+					"httppath": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"is_cluster": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+						Computed:    true,
+					},
+					"jdbc_ssl_trust_server_certificate": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+						Computed:    true,
+					},
+					"jdbc_ssl_trust_store_location": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"jdbc_ssl_trust_store_password": {
+						Type:             schema.TypeString,
+						Description:      "",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"kerberos_host_fqdn": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_kdc": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_retry_count": {
+						Type:        schema.TypeInt,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_service_kdc": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_service_realm": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_spn": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"key_file": {
+						Type:        schema.TypeString,
+						Description: "Private key file name. Allows you to provide your private key in this separate file.",
+						Optional:    true,
+					},
+					"keytab_file": {
+						Type:        schema.TypeString,
+						Description: "Specify a non-default keytab location",
+						Optional:    true,
+					},
+					"kinit_program_path": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"net_service_name": {
+						Type:        schema.TypeString,
+						Description: "Alias in tnsnames.ora replaces hostname, service name, and port in connection string",
+						Optional:    true,
+					},
+					"oauth_parameters": {
+						Type:        schema.TypeMap,
+						Description: "Additional parameters to pass when requesting a token, by parameter name",
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"odbc_connection_string": {
+						Type:        schema.TypeString,
+						Description: "Additional ODBC connection string parameters. This string will get added to the connection string",
+						Optional:    true,
+					},
+					"passphrase": {
+						Type:             schema.TypeString,
+						Description:      "Passphrase for the private key.",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"password": {
+						Type:             schema.TypeString,
+						Description:      "The password of the user being used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"principal": {
+						Type:        schema.TypeString,
+						Description: "The principal used for authentication",
+						Optional:    true,
+					},
+					"proxy_auto_detect": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"proxy_password": {
+						Type:             schema.TypeString,
+						Description:      "",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"proxy_port": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"proxy_server": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"proxy_ssl_type": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"reason": {
+						Type:        schema.TypeString,
+						Description: "N/A",
+						Required:    true,
+					},
+					"redirect_uri": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Description: "The cloud geography/region/zone/data center that the resource resides",
+						Optional:    true,
+					},
+					"replica_set": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"resource_id": {
+						Type:        schema.TypeString,
+						Description: "Azure resource application ID",
+						Optional:    true,
+					},
+					"role_name": {
+						Type:        schema.TypeString,
+						Description: "Role to use for authentication",
+						Optional:    true,
+					},
+					"schema": {
+						Type:        schema.TypeString,
+						Description: "Schema name. A schema is a logical grouping of database objects",
+						Optional:    true,
+					},
+					"sec_before_operating_expired_token": {
+						Type:        schema.TypeInt,
+						Description: "",
+						Optional:    true,
+					},
+					"secret_key": {
+						Type:             schema.TypeString,
+						Description:      "",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"self_signed": {
+						Type:        schema.TypeBool,
+						Description: "Accept self-signed certificates",
+						Optional:    true,
+						Computed:    true,
+					},
+					"self_signed_cert": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+						Computed:    true,
+					},
+					"server_port": {
+						Type:        schema.TypeInt,
+						Description: "",
+						Optional:    true,
+					},
+					"service_key": {
+						Type:             schema.TypeString,
+						Description:      "The service key required in the logdna url query to connect to logdna and pull the logs",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"session_token": {
+						Type:             schema.TypeString,
+						Description:      "The session token used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"sid": {
+						Type:        schema.TypeString,
+						Description: "SID used to connect, e.g. ORCL",
+						Optional:    true,
+					},
+					"snowflake_role": {
+						Type:        schema.TypeString,
+						Description: "Role with which to log into Snowflake",
+						Optional:    true,
+					},
+					"ssl": {
+						Type:        schema.TypeBool,
+						Description: "If true, use SSL when connecting",
+						Optional:    true,
+					},
+					"ssl_server_cert": {
+						Type:        schema.TypeString,
+						Description: "Path to server certificate to use during authentication",
+						Optional:    true,
+					},
+					"tenant_id": {
+						Type:        schema.TypeString,
+						Description: "Azure tenant ID",
+						Optional:    true,
+					},
+					"thrift_transport": {
+						Type:        schema.TypeInt,
+						Description: "",
+						Optional:    true,
+					},
+					"tmp_user": {
+						Type:        schema.TypeBool,
+						Description: "If true create a temporary user",
+						Optional:    true,
+						Computed:    true,
+					},
+					"token": {
+						Type:             schema.TypeString,
+						Description:      "Saved token to use for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"token_endpoint": {
+						Type:        schema.TypeString,
+						Description: "URL of endpoint to query when requesting a token",
+						Optional:    true,
+					},
+					"transportmode": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"unmodelled_connection_data": {
+						Type:        schema.TypeString,
+						Description: "JSON object of the connectionData fields returned by the DSF Hub that the provider does not model",
+						Computed:    true,
+					},
+					"use_keytab": {
+						Type:        schema.TypeBool,
+						Description: "If true, authenticate using a key tab",
+						Optional:    true,
+						Computed:    true,
+					},
+					"username": {
+						Type:        schema.TypeString,
+						Description: "The user to use when connecting",
+						Optional:    true,
+					},
+					"virtual_hostname": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"virtual_ip": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"wallet_dir": {
+						Type:        schema.TypeString,
+						Description: "Path to the Oracle wallet directory",
+						Optional:    true,
+					},
+					"warehouse": {
+						Type:        schema.TypeString,
+						Description: "The name of the warehouse to connect to",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"asset_display_name": {
+			Type:        schema.TypeString,
+			Description: "User-friendly name of the asset, defined by user.",
+			Required:    true,
+		},
+		"asset_id": {
+			Type:        schema.TypeString,
+			Description: "The unique identifier or resource name of the asset. For cloud data sources, the resource name or ID, e.g. /subscriptions/my-subscription-id/resourceGroups/my-resource-group/providers/Microsoft.DocumentDb/databaseAccounts/my-cosmos-table. For other data sources, derived from server_host_name, server_type, service_name and server_port when not set, e.g. mydbhost:MYSQL:my-db-service-name:3306",
+			Optional:    true,
+			Computed:    true,
+		},
+		"asset_source": {
+			Type:        schema.TypeString,
+			Description: "The source platform/vendor/system of the asset data. Usually the service responsible for creating that asset document",
+			Optional:    true,
+		},
+		"asset_version": {
+			Type:        schema.TypeFloat,
+			Description: "Denotes the version of the asset",
+			Optional:    true,
+		},
+		"audit_info": {
+			Type:        schema.TypeSet,
+			Description: "Normally auto-populated when enabling the audit policy, it is a sub-document in JSON format containing configuration information for audit management. See documentation for values that can be added manually depending on asset type. Editing this value does NOT enable the audit policy.",
+			Optional:    true,
+			Set:         resourceAssetDataAuditInfoHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy_template_name": {
+						Type:        schema.TypeString,
+						Description: "Policy template name",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"audit_pull_enabled": {
+			Type:        schema.TypeBool,
+			Description: "If true, sonargateway will collect the audit logs for this system if it can.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"audit_reconnect_reason": {
+			Type:        schema.TypeString,
+			Description: "Set at plan time when a change to a reconnect-triggering attribute of a connected asset will cause audit collection to be briefly interrupted while the asset is reconnected to gateway.",
+			Computed:    true,
+		},
+		"audit_type": {
+			Type:        schema.TypeString,
+			Description: "Used to indicate what mechanism should be used to fetch logs on systems supporting multiple ways to get logs, see asset specific documentation for details",
+			Optional:    true,
+		},
+		"availability_zones": {
+			Type:        schema.TypeList,
+			Description: "List of regions where the cluster is available",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"available_bucket_account_ids": {
+			Type:        schema.TypeList,
+			Description: "A list of S3 bucket Account IDs",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"available_regions": {
+			Type:        schema.TypeList,
+			Description: "A list of regions to use in discovery actions that iterate through region",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"aws_proxy_config": {
+			Type:        schema.TypeSet,
+			Description: "AWS specific proxy configuration",
+			Optional:    true,
+			Set:         resourceAssetDataAWSProxyConfigHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http": {
+						Type:        schema.TypeString,
+						Description: "HTTP endpoint for aws proxy config",
+						Optional:    true,
+					},
+					"https": {
+						Type:        schema.TypeString,
+						Description: "HTTPS endpoint for aws proxy config",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"bucket_account_id": {
+			Type:        schema.TypeString,
+			Description: "S3 bucket Account ID",
+			Optional:    true,
+		},
+		"ca_certs_path": {
+			Type:        schema.TypeString,
+			Description: "Certificate authority certificates path; what location should the sysetm look for certificate information from. Equivalent to --capath in a curl call",
+			Optional:    true,
+		},
+		"ca_file": {
+			Type:        schema.TypeString,
+			Description: "Path to a certificate authority file to use with the call. Equivalent to --cacert in a curl call",
+			Optional:    true,
+		},
+		"cluster_engine": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+			Computed:    true,
+		},
+		"cluster_member_id": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"cluster_name": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+			Computed:    true,
+		},
+		"content_type": {
+			Type:        schema.TypeString,
+			Description: "Content type should be set to the desired <'parent' asset 'Server Type'>, which is the one that uses this asset as a destination for logs. NOTE: The content_type field will take precedence on the lookup for parent_asset_id field when checking which server is sending logs to this asset.",
+			Optional:    true,
+		},
+		"credentials_endpoint": {
+			Type:        schema.TypeString,
+			Description: "A specific sts endpoint to use",
+			Optional:    true,
+		},
+		"criticality": {
+			Type:        schema.TypeInt,
+			Description: "The asset's importance to the business. These values are measured on a scale from \"Most critical\" (1) to \"Least critical\" (4). Allowed values: 1, 2, 3, 4",
+			Optional:    true,
+		},
+		"database_name": {
+			Type:        schema.TypeString,
+			Description: "Specifies the name of the database (or default DB) to connect to.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"db_engine": {
+			Type:        schema.TypeString,
+			Description: "Specifies the version of the engine being used by the database (e.g. oracle-ee, oracle-se, oracle-se1, oracle-se2)",
+			Optional:    true,
+		},
+		"db_instances_display_name": {
+			Type:        schema.TypeList,
+			Description: "",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"deletion_protection": {
+			Type:        schema.TypeBool,
+			Description: "If true, destroying the resource fails instead of deleting the asset, including when a change replaces it. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"duration_threshold": {
+			Type:        schema.TypeInt,
+			Description: "",
+			Optional:    true,
+		},
+		"enable_audit_management": {
+			Type:        schema.TypeBool,
+			Description: "If true, Sonar is responsible for setting and updating the policies",
+			Optional:    true,
+		},
+		"enable_audit_monitoring": {
+			Type:        schema.TypeBool,
+			Description: "If true, Sonar sends emails/alerts when the audit policies change.",
+			Optional:    true,
+		},
+		"enabled_logs_exports": {
+			Type:        schema.TypeList,
+			Description: "",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"entitlement_enabled": {
+			Type:        schema.TypeBool,
+			Description: "If true, Entitlement Management system is enabled.",
+			Optional:    true,
+		},
+		"extra_asset_data": {
+			Type:             schema.TypeString,
+			Description:      "JSON object of assetData fields that the provider does not model, merged into the asset sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+			Optional:         true,
+			ValidateDiagFunc: validateJsonObject,
+		},
+		"gateway_id": {
+			Type:        schema.TypeString,
+			Description: "The jsonarUid unique identifier of the agentless gateway. Example: '7a4af7cf-4292-89d9-46ec-183756ksdjd'",
+			Required:    true,
+		},
+		"gateway_service": {
+			Type:        schema.TypeString,
+			Description: "The name of the gateway pull service (if any) used to retrieve logs for this source. Usually set by the connect gateway playbook.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"host_timezone_offset": {
+			Type:        schema.TypeString,
+			Description: "The offset value string is in the format \"-/+hh:mm\"",
+			Optional:    true,
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier for the asset",
+			Computed:    true,
+		},
+		"ignore_latest_of": {
+			Type:        schema.TypeString,
+			Description: "A regex defining a group. From all the files with the same group, the latest one will be ignored, so that it isn't archived until server is done writing",
+			Optional:    true,
+		},
+		"is_cluster": {
+			Type:        schema.TypeBool,
+			Description: "",
+			Optional:    true,
+			Computed:    true,
+		},
+		"is_multi_zones": {
+			Type:        schema.TypeBool,
+			Description: "",
+			Optional:    true,
+		},
+		"jsonar_uid": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier (UID) attached to the Agentless Gateway controlling the asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"jsonar_uid_display_name": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier (UID) attached to the Agentless Gateway controlling the asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"location": {
+			Type:        schema.TypeString,
+			Description: "Current human-readable description of the physical location of the asset, or region.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"log_bucket_id": {
+			Type:        schema.TypeString,
+			Description: "Asset ID of the S3 bucket which stores the logs for this server",
+			Optional:    true,
+		},
+		"logs_destination_asset_id": {
+			Type:        schema.TypeString,
+			Description: "The asset name of the log aggregator that stores this asset's logs.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"managed_by": {
+			Type:        schema.TypeString,
+			Description: "Email of the person who maintains the asset; can be different from the owner specified in the owned_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"marker_alias": {
+			Type:        schema.TypeString,
+			Description: "Cluster or System name for a DR pair or similar system where all nodes share a single log. All machines sharing a marker alias will use the same marker. This means that the log will be pulled once rather than once per machine.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_concurrent_conn": {
+			Type:        schema.TypeString,
+			Description: "Maximum number of concurrent connections that sensitive data management should use at once.",
+			Optional:    true,
+		},
+		"override_ownership": {
+			Type:        schema.TypeBool,
+			Description: "If true, the asset is updated and deleted even if its ownership marker is not the workspace_id of the provider, and takes the ownership marker of the workspace on update. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"owned_by": {
+			Type:        schema.TypeString,
+			Description: "Email of Owner / person responsible for the asset; can be different from the person in the managed_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"parent_asset_id": {
+			Type:        schema.TypeString,
+			Description: "The name of an asset that this asset is part of (/related to). E.g. an AWS resource will generally have an AWS account asset as its parent. Also used to connect some log aggregating asset with the sources of their logs. E.g. An AWS LOG GROUP asset can have an AWS RDS as its parent, indicating that that is the log group for that RDS.",
+			Optional:    true,
+		},
+		"provider_url": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"proxy": {
+			Type:        schema.TypeString,
+			Description: "Proxy to use for AWS calls if aws_proxy_config is populated the proxy field will get populated from the http value there",
+			Optional:    true,
+		},
+		"pubsub_subscription": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"reconnect_on_change": {
+			Type:        schema.TypeList,
+			Description: "Additional attributes that cause a connected asset to be reconnected to gateway when changed, on top of the default reconnect-triggering attributes for this resource. Each name must be an argument of the resource. Example: [\"admin_email\", \"asset_version\"]",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "For cloud systems with regions, the default region or region used with this asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"resource_id": {
+			Type:        schema.TypeString,
+			Description: "AWS Resource ID that the audit logs will be stored under on S3. E.g. db-3TBJU4Y34IAVE2DQRQUWYOEX3I",
+			Optional:    true,
+		},
+		"sdm_enabled": {
+			Type:        schema.TypeBool,
+			Description: "Sensitive data management (SDM) is enabled if this parameter is set to True.",
+			Optional:    true,
+		},
+		"searches": {
+			Type:        schema.TypeList,
+			Description: "",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"server_host_name": {
+			Type:        schema.TypeString,
+			Description: "Hostname (or IP if name is unknown)",
+			Optional:    true,
+			Computed:    true,
+		},
+		"server_ip": {
+			Type:        schema.TypeString,
+			Description: "IP address of the service where this asset is located. If no IP is available populate this field with other information that would identify the system e.g. hostname or AWS ARN, etc.",
+			Optional:    true,
+		},
+		"server_port": {
+			Type:        schema.TypeString,
+			Description: "Port used by the source server",
+			Optional:    true,
+			Computed:    true,
+		},
+		"server_type": {
+			Type:        schema.TypeString,
+			Description: "The type of server or data service to be created as a data source.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service_endpoint": {
+			Type:        schema.TypeString,
+			Description: "Specify a particular endpoint for a given service",
+			Optional:    true,
+		},
+		"service_endpoints": {
+			Type:        schema.TypeSet,
+			Description: "Specify particular endpoints for a given service in the form of <service name>: \"endpoint\"",
+			Optional:    true,
+			Set:         resourceAssetDataServiceEndpointsHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"logs": {
+						Type:        schema.TypeString,
+						Description: "The log endpoint for a given service",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"service_name": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+			Computed:    true,
+		},
+		"ssl": {
+			Type:        schema.TypeBool,
+			Description: "",
+			Optional:    true,
+		},
+		"strict_audit": {
+			Type:        schema.TypeBool,
+			Description: "If true, failing to verify that audit collection was enabled or disabled on the DSF Hub is reported as an error instead of a warning. Audit failures are also errors when strict_audit is enabled on the provider.",
+			Optional:    true,
+			Default:     false,
+		},
+		"subscription_id": {
+			Type:        schema.TypeString,
+			Description: "This is the Azure account subscription ID. You can find this number under the Subscriptions page on the Azure portal",
+			Optional:    true,
+			Computed:    true,
+		},
+		"unmodelled_asset_data": {
+			Type:        schema.TypeString,
+			Description: "JSON object of the assetData fields returned by the DSF Hub that the provider does not model",
+			Computed:    true,
+		},
+		"used_for": {
+			Type:        schema.TypeString,
+			Description: "Designates how this asset is used / the environment that the asset is supporting.",
+			Optional:    true,
+		},
+		"virtual_hostname": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"virtual_ip": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"xel_directory": {
+			Type:        schema.TypeString,
+			Description: "Absolute path of the XEL files location",
+			Optional:    true,
+		},
+	}
+}
+
+// resourceLogAggregatorSchema returns the attributes of dsfhub_log_aggregator
+func resourceLogAggregatorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"admin_email": {
+			Type:        schema.TypeString,
+			Description: "The email address to notify about this asset",
+			Required:    true,
+		},
+		"adopt_existing": {
+			Type:        schema.TypeBool,
+			Description: "If true, creating the resource adopts an existing asset with the same asset_id instead of failing: the asset is updated to match the configuration and managed by Terraform from then on. Adoption is also enabled for all resources by adopt_existing on the provider. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"allow_asset_rename": {
+			Type:        schema.TypeBool,
+			Description: "If true, changing asset_id recreates the asset with dependent repointing instead of replacing it: the asset is created with the new asset_id, the assets referencing the old asset_id, e.g. in parent_asset_id or secret_asset_id, are pointed at the new one, and the old asset is deleted. The rename fails if one of them is owned by another workspace, and is rolled back if a step fails before the old asset is deleted. This is not an in-place rename: the audit history and gateway state of the old asset are not carried over to the new one. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"application": {
+			Type:        schema.TypeString,
+			Description: "The Asset ID of the application asset that \"owns\" the asset.",
+			Optional:    true,
+		},
+		"arn": {
+			Type:        schema.TypeString,
+			Description: "Amazon Resource Name - format is arn:partition:service:region:account-id and used as the asset_id",
+			Optional:    true,
+			Computed:    true,
+		},
+		"asset_checksum": {
+			Type:        schema.TypeString,
+			Description: "Checksum of the asset as last read from the DSF Hub, used to detect changes made outside of Terraform when update_conflict_check is enabled on the provider",
+			Computed:    true,
+		},
+		"asset_connection": {
+			Type:        schema.TypeList,
+			Description: "N/A",
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"access_id": {
+						Type:        schema.TypeString,
+						Description: "The Access key ID of AWS secret access key used for authentication",
+						Optional:    true,
+						Computed:    true,
+					},
+					"access_key": {
+						Type:             schema.TypeString,
+						Description:      "The Secret access key used for authentication",
+						Optional:         true,
+						Computed:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"amazon_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with AWS Secrets Manager",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataAmazonSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for AWS secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "AWS secret asset id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "AWS secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"application_id": {
+						Type:        schema.TypeString,
+						Description: "This is also referred to as the Client ID and it’s the unique identifier for the registered application being used to execute Python SDK commands against Azure’s API services. You can find this number under Azure Active Directory -> App Registrations -> Owned Applications",
+						Optional:    true,
+						Computed:    true,
+					},
+					"auth_mechanism": {
+						Type:         schema.TypeString,
+						Description:  "Specifies the auth mechanism used by the connection",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default", "service_account", "azure_ad", "kerberos", "client_secret"}, false),
+					},
+					"azure_storage_account": {
+						Type:        schema.TypeString,
+						Description: "The name of the unique namespace where the EventHub is located. The field can contain only lowercase letters and numbers. Name must be between 3 and 24 characters.",
+						Optional:    true,
+					},
+					"azure_storage_container": {
+						Type:        schema.TypeString,
+						Description: "Location where a given EventHub’s processing is stored (One storage container per EventHub). This name may only contain lowercase letters, numbers, and hyphens, and must begin with a letter or a number. Each hyphen must be preceded and followed by a non-hyphen character. The name must also be between 3 and 63 characters long.",
+						Optional:    true,
+					},
+					"azure_storage_secret_key": {
+						Type:             schema.TypeString,
+						Description:      "Azure Storage Secret Key",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"ca_certs_path": {
+						Type:        schema.TypeString,
+						Description: "Certificate authority certificates path; what location should the sysetm look for certificate information from. Equivalent to --capath in a curl call",
+						Optional:    true,
+					},
+					"cache_file": {
+						Type:        schema.TypeString,
+						Description: "Holds Kerberos protocol credentials (for example, tickets, session keys and other identifying information).",
+						Optional:    true,
+					},
+					"client_secret": {
+						Type:             schema.TypeString,
+						Description:      "This a string containing a secret used by the application to prove its identity when requesting a token. You can get a secret by going to Azure Active Directory -> App Registrations -> Owned Applications, selecting the desired application and then going to Certificates & secrets -> Client secrets -> + New client secret",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"cyberark_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with CyberArk Secrets Manager",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataCyberarkSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for CyberArk secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"db_role": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"directory_id": {
+						Type:        schema.TypeString,
+						Description: "This is also referred to as the Tenant ID and is a GUID representing the Active Directory Tenant. It can be found in the Azure Active Directory page under the Azure portal",
+						Optional:    true,
+					},
+					"eventhub_access_key": {
+						Type:             schema.TypeString,
+						Description:      "Eventhub Access Key",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"eventhub_access_policy": {
+						Type:        schema.TypeString,
+						Description: "EventHub Access Policy",
+						Optional:    true,
+					},
+					"eventhub_name": {
+						Type:        schema.TypeString,
+						Description: "EventHub Name",
+						Optional:    true,
+					},
+					"eventhub_namespace": {
+						Type:        schema.TypeString,
+						Description: "The name for the management container that the EventHub belongs to, one namespace can contain multiple EventHubs. The namespace can contain only letters, numbers, and hyphens. The namespace must start with a letter, and it must end with a letter or number. The value must be between 6 and 50 characters long.",
+						Optional:    true,
+					},
+					"external": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+						Computed:    true,
+					},
+					"external_id": {
+						Type:        schema.TypeString,
+						Description: "External ID to use when assuming a role",
+						Optional:    true,
+					},
+					"extra_connection_data": {
+						Type:             schema.TypeString,
+						Description:      "JSON object of connectionData fields that the provider does not model, merged into the connection sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+						Optional:         true,
+						ValidateDiagFunc: validateJsonObject,
+					},
+					"extra_kinit_parameters": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"format": {
+						Type:        schema.TypeString,
+						Description: "External ID to use when assuming a role",
+						Optional:    true,
+					},
+					"hashicorp_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with HashiCorp Vault",
+						Optional:    true,
+						Set:         resourceConnectionDataHashicorpSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for HashiCorp secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"path": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret path",
+									Optional:    true,
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"kerberos_kdc": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_service_kdc": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_service_realm": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"kerberos_spn": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"key_file": {
+						Type:        schema.TypeString,
+						Description: "Location on disk on the key to be used for authentication",
+						Optional:    true,
+					},
+					"keytab_file": {
+						Type:        schema.TypeString,
+						Description: "Specify a non-default keytab location",
+						Optional:    true,
+					},
+					"kinit_program_path": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"passphrase": {
+						Type:             schema.TypeString,
+						Description:      "Passphrase for the private key.",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"password": {
+						Type:             schema.TypeString,
+						Description:      "The password of the user being used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"principal": {
+						Type:        schema.TypeString,
+						Description: "The principal used for authentication",
+						Optional:    true,
+					},
+					"reason": {
+						Type:         schema.TypeString,
+						Description:  "N/A",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default"}, false),
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Description: "Default AWS region for this asset",
+						Optional:    true,
+					},
+					"role_name": {
+						Type:        schema.TypeString,
+						Description: "What role is used to get credentials from.",
+						Optional:    true,
+					},
+					"secret_key": {
+						Type:             schema.TypeString,
+						Description:      "The Secret access key used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"ssl": {
+						Type:        schema.TypeBool,
+						Description: "If true, use SSL when connecting",
+						Optional:    true,
+						Default:     false,
+					},
+					"ssl_server_cert": {
+						Type:        schema.TypeString,
+						Description: "Path to server certificate to use during authentication",
+						Optional:    true,
+					},
+					"subscription_id": {
+						Type:        schema.TypeString,
+						Description: "This is the Azure account subscription ID. You can find this number under the Subscriptions page on the Azure portal",
+						Optional:    true,
+					},
+					"unmodelled_connection_data": {
+						Type:        schema.TypeString,
+						Description: "JSON object of the connectionData fields returned by the DSF Hub that the provider does not model",
+						Computed:    true,
+					},
+					"use_keytab": {
+						Type:        schema.TypeBool,
+						Description: "If true, authenticate using a key tab",
+						Optional:    true,
+						Computed:    true,
+					},
+					"user_identity_client_id": {
+						Type:        schema.TypeString,
+						Description: "The client ID of a user-assigned managed identity.",
+						Optional:    true,
+					},
+					"username": {
+						Type:        schema.TypeString,
+						Description: "The name of a profile in /imperva/local/credentials/.aws/credentials to use for authenticating",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"asset_display_name": {
+			Type:        schema.TypeString,
+			Description: "User-friendly name of the asset, defined by user.",
+			Optional:    true,
+		},
+		"asset_id": {
+			Type:        schema.TypeString,
+			Description: "The following format is a suggestion that guarantees uniqueness and identifiability between AZURE assets using different applications/resource groups: 'directory_id/<directory_id>/subscription_id/<subscription_id>/<resource group name>/application_id/<application_id>'",
+			Required:    true,
+		},
+		"asset_source": {
+			Type:        schema.TypeString,
+			Description: "The source platform/vendor/system of the asset data. Usually the service responsible for creating that asset document",
+			Optional:    true,
+		},
+		"asset_version": {
+			Type:        schema.TypeFloat,
+			Description: "Denotes the version of the asset",
+			Optional:    true,
+		},
+		"audit_data_type": {
+			Type:        schema.TypeString,
+			Description: "The type of audit data being collected",
+			Optional:    true,
+		},
+		"audit_info": {
+			Type:        schema.TypeSet,
+			Description: "Normally auto-populated when enabling the audit policy, it is a sub-document in JSON format containing configuration information for audit management. See documentation for values that can be added manually depending on asset type. Editing this value does NOT enable the audit policy.",
+			Optional:    true,
+			Set:         resourceAssetDataAuditInfoHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy_template_name": {
+						Type:        schema.TypeString,
+						Description: "Policy template name",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"audit_pull_enabled": {
+			Type:        schema.TypeBool,
+			Description: "If true, sonargateway will collect the audit logs for this system if it can.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"audit_reconnect_reason": {
+			Type:        schema.TypeString,
+			Description: "Set at plan time when a change to a reconnect-triggering attribute of a connected asset will cause audit collection to be briefly interrupted while the asset is reconnected to gateway.",
+			Computed:    true,
+		},
+		"audit_type": {
+			Type:        schema.TypeString,
+			Description: "Used to indicate what mechanism should be used to fetch logs on systems supporting multiple ways to get logs, see asset specific documentation for details.  Example: \"BIGQUERY\",\"BIGTABLE\",\"BUCKET\",\"MSSQL\",\"MYSQL\",\"POSTGRESQL\",\"SPANNER\"",
+			Optional:    true,
+		},
+		"available_bucket_account_ids": {
+			Type:        schema.TypeList,
+			Description: "A list of S3 bucket Account IDs",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"available_regions": {
+			Type:        schema.TypeList,
+			Description: "A list of regions to use in discovery actions that iterate through region",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"aws_proxy_config": {
+			Type:        schema.TypeSet,
+			Description: "AWS specific proxy configuration",
+			Optional:    true,
+			Set:         resourceAssetDataAWSProxyConfigHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http": {
+						Type:        schema.TypeString,
+						Description: "HTTP endpoint for aws proxy config",
+						Optional:    true,
+					},
+					"https": {
+						Type:        schema.TypeString,
+						Description: "HTTPS endpoint for aws proxy config",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"bucket_account_id": {
+			Type:        schema.TypeString,
+			Description: "S3 bucket Account ID",
+			Optional:    true,
+		},
+		"ca_certs_path": {
+			Type:        schema.TypeString,
+			Description: "Certificate authority certificates path; what location should the sysetm look for certificate information from. Equivalent to --capath in a curl call",
+			Optional:    true,
+		},
+		"ca_file": {
+			Type:        schema.TypeString,
+			Description: "Path to a certificate authority file to use with the call. Equivalent to --cacert in a curl call",
+			Optional:    true,
+		},
+		"consumer_group": {
+			Type:        schema.TypeString,
+			Description: "The name of the consumer group to use when pulling data from the logstore.",
+			Optional:    true,
+		},
+		"consumer_group_workers": {
+			Type:        schema.TypeString,
+			Description: "Only applies if Pull Type is consumer_group. The number of consumers that will be part of the consumer group. For best performance this should match the number of shards in your logstore.",
+			Optional:    true,
+		},
+		"consumer_worker_prefix": {
+			Type:        schema.TypeString,
+			Description: "The prefix to use for the consumer worker name. This can be useful if you are trying to pull from the same consumer group on different gateways",
+			Optional:    true,
+		},
+		"content_type": {
+			Type:        schema.TypeString,
+			Description: "content_type should be set to the desired <'parent' asset 'Server Type'>, which is the one that uses this asset as a destination for logs. NOTE: The content_type field will take precedence on the lookup for parent_asset_id field when checking which server is sending logs to this asset.",
+			Optional:    true,
+		},
+		"credentials_endpoint": {
+			Type:        schema.TypeString,
+			Description: "A specific sts endpoint to use",
+			Optional:    true,
+		},
+		"criticality": {
+			Type:        schema.TypeInt,
+			Description: "The asset's importance to the business. These values are measured on a scale from \"Most critical\" (1) to \"Least critical\" (4). Allowed values: 1, 2, 3, 4",
+			Optional:    true,
+		},
+		"database_name": {
+			Type:        schema.TypeString,
+			Description: "Specifies the name of the database (or default DB) to connect to.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"db_engine": {
+			Type:        schema.TypeString,
+			Description: "Specifies the version of the engine being used by the database (e.g. oracle-ee, oracle-se, oracle-se1, oracle-se2)",
+			Optional:    true,
+		},
+		"deletion_protection": {
+			Type:        schema.TypeBool,
+			Description: "If true, destroying the resource fails instead of deleting the asset, including when a change replaces it. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Description: "Logstore's endpoint",
+			Optional:    true,
+		},
+		"extra_asset_data": {
+			Type:             schema.TypeString,
+			Description:      "JSON object of assetData fields that the provider does not model, merged into the asset sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+			Optional:         true,
+			ValidateDiagFunc: validateJsonObject,
+		},
+		"force_destroy": {
+			Type:        schema.TypeBool,
+			Description: "If true, the asset is deleted even if other assets depend on it, which otherwise fails with their asset_ids. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"gateway_id": {
+			Type:        schema.TypeString,
+			Description: "The jsonarUid unique identifier of the agentless gateway. Example: '7a4af7cf-4292-89d9-46ec-183756ksdjd'",
+			Required:    true,
+		},
+		"gateway_service": {
+			Type:        schema.TypeString,
+			Description: "The name of the gateway pull service (if any) used to retrieve logs for this source. Usually set by the connect gateway playbook.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier for the asset",
+			Computed:    true,
+		},
+		"jsonar_uid": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier (UID) attached to the Agentless Gateway controlling the asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"location": {
+			Type:        schema.TypeString,
+			Description: "Current human-readable description of the physical location of the asset, or region.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"logs_destination_asset_id": {
+			Type:        schema.TypeString,
+			Description: "The asset name of the log aggregator that stores this asset's logs.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"logstore": {
+			Type:        schema.TypeString,
+			Description: "Unit that is used to collect, store and query logs",
+			Optional:    true,
+			Computed:    true,
+		},
+		"managed_by": {
+			Type:        schema.TypeString,
+			Description: "Email of the person who maintains the asset; can be different from the owner specified in the owned_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_concurrent_conn": {
+			Type:        schema.TypeString,
+			Description: "Maximum number of concurrent connections that sensitive data management should use at once.",
+			Optional:    true,
+		},
+		"override_ownership": {
+			Type:        schema.TypeBool,
+			Description: "If true, the asset is updated and deleted even if its ownership marker is not the workspace_id of the provider, and takes the ownership marker of the workspace on update. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"owned_by": {
+			Type:        schema.TypeString,
+			Description: "Email of Owner / person responsible for the asset; can be different from the person in the managed_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"parent_asset_id": {
+			Type:        schema.TypeString,
+			Description: "The name of an asset that this asset is part of (/related to). E.g. an AWS resource will generally have an AWS account asset as its parent. Also used to connect some log aggregating asset with the sources of their logs. E.g. An AWS LOG GROUP asset can have an AWS RDS as its parent, indicating that that is the log group for that RDS.",
+			Optional:    true,
+		},
+		"project": {
+			Type:        schema.TypeString,
+			Description: "Project separates different resources of multiple users and control access to specific resources",
+			Optional:    true,
+			Computed:    true,
+		},
+		"proxy": {
+			Type:        schema.TypeString,
+			Description: "Proxy to use for AWS calls if aws_proxy_config is populated the proxy field will get populated from the http value there",
+			Optional:    true,
+		},
+		"pubsub_subscription": {
+			Type:        schema.TypeString,
+			Description: "Pub/Sub Subscription. Example: projects/my-project-name/subscriptions/my-subscription-name",
+			Optional:    true,
+		},
+		"pull_type": {
+			Type:        schema.TypeString,
+			Description: "The method used to pull data from the logstore.",
+			Optional:    true,
+		},
+		"reconnect_on_change": {
+			Type:        schema.TypeList,
+			Description: "Additional attributes that cause a connected asset to be reconnected to gateway when changed, on top of the default reconnect-triggering attributes for this resource. Each name must be an argument of the resource. Example: [\"admin_email\", \"asset_version\"]",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "For cloud systems with regions, the default region or region used with this asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"s3_provider": {
+			Type:        schema.TypeString,
+			Description: "Accepted value: \"aws-rds-mssql\", required only for AWS RDS MS SQL SERVER auditing workflow.",
+			Optional:    true,
+		},
+		"sdm_enabled": {
+			Type:        schema.TypeBool,
+			Description: "Sensitive data management (SDM) is enabled if this parameter is set to True.",
+			Optional:    true,
+		},
+		"server_host_name": {
+			Type:        schema.TypeString,
+			Description: "Hostname (or IP if name is unknown)",
+			Optional:    true,
+			Computed:    true,
+		},
+		"server_ip": {
+			Type:        schema.TypeString,
+			Description: "IP address of the service where this asset is located. If no IP is available populate this field with other information that would identify the system e.g. hostname or AWS ARN, etc.",
+			Optional:    true,
+		},
+		"server_port": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+			Computed:    true,
+		},
+		"server_type": {
+			Type:        schema.TypeString,
+			Description: "The type of server or data service to be created as a data source.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service_endpoints": {
+			Type:        schema.TypeSet,
+			Description: "Specify particular endpoints for a given service in the form of <service name>: \"endpoint\"",
+			Optional:    true,
+			Set:         resourceAssetDataServiceEndpointsHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"logs": {
+						Type:        schema.TypeString,
+						Description: "The log endpoint for a given service",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"strict_audit": {
+			Type:        schema.TypeBool,
+			Description: "If true, failing to verify that audit collection was enabled or disabled on the DSF Hub is reported as an error instead of a warning. Audit failures are also errors when strict_audit is enabled on the provider.",
+			Optional:    true,
+			Default:     false,
+		},
+		"unmodelled_asset_data": {
+			Type:        schema.TypeString,
+			Description: "JSON object of the assetData fields returned by the DSF Hub that the provider does not model",
+			Computed:    true,
+		},
+		"used_for": {
+			Type:        schema.TypeString,
+			Description: "Designates how this asset is used / the environment that the asset is supporting.",
+			Optional:    true,
+		},
+	}
+}
+
+// resourceCloudAccountSchema returns the attributes of dsfhub_cloud_account
+func resourceCloudAccountSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"admin_email": {
+			Type:        schema.TypeString,
+			Description: "The email address to notify about this asset",
+			Required:    true,
+		},
+		"adopt_existing": {
+			Type:        schema.TypeBool,
+			Description: "If true, creating the resource adopts an existing asset with the same asset_id instead of failing: the asset is updated to match the configuration and managed by Terraform from then on. Adoption is also enabled for all resources by adopt_existing on the provider. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"allow_asset_rename": {
+			Type:        schema.TypeBool,
+			Description: "If true, changing asset_id recreates the asset with dependent repointing instead of replacing it: the asset is created with the new asset_id, the assets referencing the old asset_id, e.g. in parent_asset_id or secret_asset_id, are pointed at the new one, and the old asset is deleted. The rename fails if one of them is owned by another workspace, and is rolled back if a step fails before the old asset is deleted. This is not an in-place rename: the audit history and gateway state of the old asset are not carried over to the new one. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"application": {
+			Type:        schema.TypeString,
+			Description: "The Asset ID of the application asset that \"owns\" the asset.",
+			Optional:    true,
+		},
+		"arn": {
+			Type:        schema.TypeString,
+			Description: "Amazon Resource Name - format is arn:partition:service:region:account-id and used as the asset_id",
+			Optional:    true,
+			Computed:    true,
+		},
+		"asset_checksum": {
+			Type:        schema.TypeString,
+			Description: "Checksum of the asset as last read from the DSF Hub, used to detect changes made outside of Terraform when update_conflict_check is enabled on the provider",
+			Computed:    true,
+		},
+		"asset_connection": {
+			Type:        schema.TypeList,
+			Description: "N/A",
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"access_id": {
+						Type:        schema.TypeString,
+						Description: "The Access key ID of AWS secret access key used for authentication",
+						Optional:    true,
+					},
+					"access_key": {
+						Type:             schema.TypeString,
+						Description:      "The Secret access key used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"amazon_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with AWS Secrets Manager",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataAmazonSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for AWS secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "AWS secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "AWS secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"application_id": {
+						Type:        schema.TypeString,
+						Description: "This is also referred to as the Client ID and it’s the unique identifier for the registered application being used to execute Python SDK commands against Azure’s API services. You can find this number under Azure Active Directory -> App Registrations -> Owned Applications",
+						Optional:    true,
+					},
+					"auth_mechanism": {
+						Type:         schema.TypeString,
+						Description:  "Specifies the auth mechanism used by the connection",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"auth_file", "client_secret", "default", "iam_role", "key", "machine_role", "managed_identity", "profile", "service_account"}, false),
+					},
+					"ca_certs_path": {
+						Type:        schema.TypeString,
+						Description: "Certificate authority certificates path; what location should the sysetm look for certificate information from. Equivalent to --capath in a curl call",
+						Optional:    true,
+					},
+					"client_secret": {
+						Type:             schema.TypeString,
+						Description:      "This a string containing a secret used by the application to prove its identity when requesting a token. You can get a secret by going to Azure Active Directory -> App Registrations -> Owned Applications, selecting the desired application and then going to Certificates & secrets -> Client secrets -> + New client secret",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"cyberark_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with CyberArk",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataCyberarkSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for CyberArk secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"directory_id": {
+						Type:        schema.TypeString,
+						Description: "This is also referred to as the Tenant ID and is a GUID representing the Active Directory Tenant. It can be found in the Azure Active Directory page under the Azure portal",
+						Optional:    true,
+					},
+					"external_id": {
+						Type:        schema.TypeString,
+						Description: "External ID to use when assuming a role",
+						Optional:    true,
+					},
+					"extra_connection_data": {
+						Type:             schema.TypeString,
+						Description:      "JSON object of connectionData fields that the provider does not model, merged into the connection sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+						Optional:         true,
+						ValidateDiagFunc: validateJsonObject,
+					},
+					"hashicorp_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with HashiCorp Vault",
+						Optional:    true,
+						Set:         resourceConnectionDataHashicorpSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for HashiCorp secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"path": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret path",
+									Optional:    true,
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"key_file": {
+						Type:        schema.TypeString,
+						Description: "Location on disk on the key to be used for authentication",
+						Optional:    true,
+					},
+					"project_id": {
+						Type:        schema.TypeString,
+						Description: "Used when running Sonar on a GCP hosted environment that doesn't have a service account linked to it.",
+						Optional:    true,
+					},
+					"reason": {
+						Type:         schema.TypeString,
+						Description:  "N/A",
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default"}, false),
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Description: "Default region for this asset",
+						Optional:    true,
+					},
+					"role_name": {
+						Type:        schema.TypeString,
+						Description: "What role is used to get credentials from.",
+						Optional:    true,
+					},
+					"secret_key": {
+						Type:             schema.TypeString,
+						Description:      "The Secret access key used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"session_token": {
+						Type:             schema.TypeString,
+						Description:      "The session token used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"ssl": {
+						Type:        schema.TypeBool,
+						Description: "If true, use SSL when connecting",
+						Optional:    true,
+						Default:     false,
+					},
+					"subscription_id": {
+						Type:        schema.TypeString,
+						Description: "This is the Azure account subscription ID. You can find this number under the Subscriptions page on the Azure portal",
+						Optional:    true,
+					},
+					"unmodelled_connection_data": {
+						Type:        schema.TypeString,
+						Description: "JSON object of the connectionData fields returned by the DSF Hub that the provider does not model",
+						Computed:    true,
+					},
+					"username": {
+						Type:        schema.TypeString,
+						Description: "The name of a profile in /imperva/local/credentials/.aws/credentials to use for authenticating",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"asset_display_name": {
+			Type:        schema.TypeString,
+			Description: "User-friendly name of the asset, defined by user.",
+			Optional:    true,
+		},
+		"asset_id": {
+			Type:        schema.TypeString,
+			Description: "The following format is a suggestion that guarantees uniqueness and identifiability between AZURE assets using different applications/resource groups: 'directory_id/<directory_id>/subscription_id/<subscription_id>/<resource group name>/application_id/<application_id>'",
+			Required:    true,
+		},
+		"asset_source": {
+			Type:        schema.TypeString,
+			Description: "The source platform/vendor/system of the asset data. Usually the service responsible for creating that asset document",
+			Optional:    true,
+		},
+		"asset_version": {
+			Type:        schema.TypeFloat,
+			Description: "Denotes the version of the asset",
+			Optional:    true,
+		},
+		"available_regions": {
+			Type:        schema.TypeList,
+			Description: "A list of regions to use in discovery actions that iterate through region",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"aws_proxy_config": {
+			Type:        schema.TypeSet,
+			Description: "AWS specific proxy configuration",
+			Optional:    true,
+			Set:         resourceAssetDataAWSProxyConfigHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http": {
+						Type:        schema.TypeString,
+						Description: "HTTP endpoint for aws proxy config",
+						Optional:    true,
+					},
+					"https": {
+						Type:        schema.TypeString,
+						Description: "HTTPS endpoint for aws proxy config",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"credentials_endpoint": {
+			Type:        schema.TypeString,
+			Description: "A specific sts endpoint to use",
+			Optional:    true,
+		},
+		"criticality": {
+			Type:        schema.TypeInt,
+			Description: "The asset's importance to the business. These values are measured on a scale from \"Most critical\" (1) to \"Least critical\" (4). Allowed values: 1, 2, 3, 4",
+			Optional:    true,
+		},
+		"deletion_protection": {
+			Type:        schema.TypeBool,
+			Description: "If true, destroying the resource fails instead of deleting the asset, including when a change replaces it. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"extra_asset_data": {
+			Type:             schema.TypeString,
+			Description:      "JSON object of assetData fields that the provider does not model, merged into the asset sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+			Optional:         true,
+			ValidateDiagFunc: validateJsonObject,
+		},
+		"force_destroy": {
+			Type:        schema.TypeBool,
+			Description: "If true, the asset is deleted even if other assets depend on it, which otherwise fails with their asset_ids. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"gateway_id": {
+			Type:        schema.TypeString,
+			Description: "The jsonarUid unique identifier of the agentless gateway. Example: '7a4af7cf-4292-89d9-46ec-183756ksdjd'",
+			Required:    true,
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier for the asset",
+			Computed:    true,
+		},
+		"jsonar_uid": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier (UID) attached to the Agentless Gateway controlling the asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"location": {
+			Type:        schema.TypeString,
+			Description: "Current human-readable description of the physical location of the asset, or region.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"managed_by": {
+			Type:        schema.TypeString,
+			Description: "Email of the person who maintains the asset; can be different from the owner specified in the owned_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"override_ownership": {
+			Type:        schema.TypeBool,
+			Description: "If true, the asset is updated and deleted even if its ownership marker is not the workspace_id of the provider, and takes the ownership marker of the workspace on update. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"owned_by": {
+			Type:        schema.TypeString,
+			Description: "Email of Owner / person responsible for the asset; can be different from the person in the managed_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"proxy": {
+			Type:        schema.TypeString,
+			Description: "Proxy to use for AWS calls if aws_proxy_config is populated the proxy field will get populated from the http value there",
+			Optional:    true,
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "For cloud systems with regions, the default region or region used with this asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"server_host_name": {
+			Type:        schema.TypeString,
+			Description: "Hostname (or IP if name is unknown)",
+			Optional:    true,
+		},
+		"server_ip": {
+			Type:        schema.TypeString,
+			Description: "IP address of the service where this asset is located. If no IP is available populate this field with other information that would identify the system e.g. hostname or AWS ARN, etc.",
+			Optional:    true,
+		},
+		"server_port": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"server_type": {
+			Type:        schema.TypeString,
+			Description: "The type of server or data service to be created as a data source.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service_endpoints": {
+			Type:        schema.TypeSet,
+			Description: "Specify particular endpoints for a given service in the form of <service name>: \"endpoint\"",
+			Optional:    true,
+			Set:         resourceAssetDataServiceEndpointsHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"logs": {
+						Type:        schema.TypeString,
+						Description: "The log endpoint for a given service",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"unmodelled_asset_data": {
+			Type:        schema.TypeString,
+			Description: "JSON object of the assetData fields returned by the DSF Hub that the provider does not model",
+			Computed:    true,
+		},
+		"used_for": {
+			Type:        schema.TypeString,
+			Description: "Designates how this asset is used / the environment that the asset is supporting.",
+			Optional:    true,
+		},
+	}
+}
+
+// resourceSecretManagerSchema returns the attributes of dsfhub_secret_manager
+func resourceSecretManagerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"admin_email": {
+			Type:        schema.TypeString,
+			Description: "The email address to notify about this asset",
+			Required:    true,
+		},
+		"adopt_existing": {
+			Type:        schema.TypeBool,
+			Description: "If true, creating the resource adopts an existing asset with the same asset_id instead of failing: the asset is updated to match the configuration and managed by Terraform from then on. Adoption is also enabled for all resources by adopt_existing on the provider. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"allow_asset_rename": {
+			Type:        schema.TypeBool,
+			Description: "If true, changing asset_id recreates the asset with dependent repointing instead of replacing it: the asset is created with the new asset_id, the assets referencing the old asset_id, e.g. in parent_asset_id or secret_asset_id, are pointed at the new one, and the old asset is deleted. The rename fails if one of them is owned by another workspace, and is rolled back if a step fails before the old asset is deleted. This is not an in-place rename: the audit history and gateway state of the old asset are not carried over to the new one. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"application": {
+			Type:        schema.TypeString,
+			Description: "The Asset ID of the application asset that \"owns\" the asset.",
+			Optional:    true,
+		},
+		"arn": {
+			Type:        schema.TypeString,
+			Description: "Amazon Resource Name - format is arn:partition:service:region:account-id and used as the asset_id",
+			Optional:    true,
+			Computed:    true,
+		},
+		"asset_checksum": {
+			Type:        schema.TypeString,
+			Description: "Checksum of the asset as last read from the DSF Hub, used to detect changes made outside of Terraform when update_conflict_check is enabled on the provider",
+			Computed:    true,
+		},
+		"asset_connection": {
+			Type:        schema.TypeList,
+			Description: "N/A",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"access_id": {
+						Type:        schema.TypeString,
+						Description: "The Access key ID of AWS secret access key used for authentication",
+						Optional:    true,
+					},
+					"amazon_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with AWS Secrets Manager",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataAmazonSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for AWS secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "AWS secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "AWS secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"auth_mechanism": {
+						Type:         schema.TypeString,
+						Description:  "Specifies the auth mechanism used by the connection",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default", "root_token", "iam_role", "app_role", "ec2", "profile", "key"}, false),
+					},
+					"aws_iam_server_id": {
+						Type:        schema.TypeString,
+						Description: "e.g. vault.example.com",
+						Optional:    true,
+					},
+					"ca_certs_path": {
+						Type:        schema.TypeString,
+						Description: "Certificate authority certificates path; what location should the sysetm look for certificate information from. Equivalent to --capath in a curl call",
+						Optional:    true,
+					},
+					"cert_file": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"cyberark_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with CyberArk",
+						Optional:    true,
+						MaxItems:    1,
+						Set:         resourceConnectionDataCyberarkSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for CyberArk secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "CyberArk secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"external_id": {
+						Type:        schema.TypeString,
+						Description: "External ID to use when assuming a role",
+						Optional:    true,
+					},
+					"extra_connection_data": {
+						Type:             schema.TypeString,
+						Description:      "JSON object of connectionData fields that the provider does not model, merged into the connection sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+						Optional:         true,
+						ValidateDiagFunc: validateJsonObject,
+					},
+					"hashicorp_secret": {
+						Type:        schema.TypeSet,
+						Description: "Configuration to integrate with HashiCorp Vault",
+						Optional:    true,
+						Set:         resourceConnectionDataHashicorpSecretHash,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"field_mapping": {
+									Type:        schema.TypeMap,
+									Description: "Field mapping for HashiCorp secret",
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"path": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret path",
+									Optional:    true,
+								},
+								"secret_asset_id": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret manager asset_id",
+									Optional:    true,
+								},
+								"secret_name": {
+									Type:        schema.TypeString,
+									Description: "HashiCorp secret name",
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"headers": {
+						Type:        schema.TypeList,
+						Description: "A list of headers to include in the request",
+						Optional:    true,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"key_file": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"namespace": {
+						Type:        schema.TypeString,
+						Description: "Specifies which namespace to fetch credentials from if not root.",
+						Optional:    true,
+					},
+					"nonce": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"protocol": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"query": {
+						Type:        schema.TypeString,
+						Description: "",
+						Optional:    true,
+					},
+					"reason": {
+						Type:         schema.TypeString,
+						Description:  "N/A",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default"}, false),
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Description: "Default AWS region for this asset",
+						Optional:    true,
+					},
+					"role_name": {
+						Type:        schema.TypeString,
+						Description: "Role to use for authentication",
+						Optional:    true,
+					},
+					"secret_key": {
+						Type:             schema.TypeString,
+						Description:      "The Secret access key used for authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"self_signed": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"session_token": {
+						Type:             schema.TypeString,
+						Description:      "STS token used for session authentication",
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressMaskedSecretDiff,
+					},
+					"ssl": {
+						Type:        schema.TypeBool,
+						Description: "If true, use SSL when connecting",
+						Optional:    true,
+					},
+					"store_aws_credentials": {
+						Type:        schema.TypeBool,
+						Description: "",
+						Optional:    true,
+					},
+					"unmodelled_connection_data": {
+						Type:        schema.TypeString,
+						Description: "JSON object of the connectionData fields returned by the DSF Hub that the provider does not model",
+						Computed:    true,
+					},
+					"username": {
+						Type:        schema.TypeString,
+						Description: "The name of a profile in /imperva/local/credentials/.aws/credentials to use for authenticating",
+						Optional:    true,
+					},
+					"v2_key_engine": {
+						Type:        schema.TypeBool,
+						Description: "Use a KV2 secret engine",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"asset_display_name": {
+			Type:        schema.TypeString,
+			Description: "User-friendly name of the asset, defined by user.",
+			Optional:    true,
+		},
+		"asset_id": {
+			Type:        schema.TypeString,
+			Description: "The unique identifier or resource name of the asset. For most assets this should be a concatenation of Server Host Name + Server Type + Service Name + Server Port with “:” (colon) as separator",
+			Optional:    true,
+		},
+		"asset_source": {
+			Type:        schema.TypeString,
+			Description: "The source platform/vendor/system of the asset data. Usually the service responsible for creating that asset document",
+			Optional:    true,
+		},
+		"asset_version": {
+			Type:        schema.TypeFloat,
+			Description: "Denotes the version of the asset",
+			Optional:    true,
+		},
+		"available_regions": {
+			Type:        schema.TypeList,
+			Description: "A list of regions to use in discovery actions that iterate through region",
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"aws_proxy_config": {
+			Type:        schema.TypeSet,
+			Description: "AWS specific proxy configuration",
+			Optional:    true,
+			Set:         resourceAssetDataAWSProxyConfigHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http": {
+						Type:        schema.TypeString,
+						Description: "HTTP endpoint for aws proxy config",
+						Optional:    true,
+					},
+					"https": {
+						Type:        schema.TypeString,
+						Description: "HTTPS endpoint for aws proxy config",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"credentials_endpoint": {
+			Type:        schema.TypeString,
+			Description: "A specific sts endpoint to use",
+			Optional:    true,
+		},
+		"criticality": {
+			Type:        schema.TypeInt,
+			Description: "The asset's importance to the business. These values are measured on a scale from \"Most critical\" (1) to \"Least critical\" (4). Allowed values: 1, 2, 3, 4",
+			Optional:    true,
+		},
+		"deletion_protection": {
+			Type:        schema.TypeBool,
+			Description: "If true, destroying the resource fails instead of deleting the asset, including when a change replaces it. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"extra_asset_data": {
+			Type:             schema.TypeString,
+			Description:      "JSON object of assetData fields that the provider does not model, merged into the asset sent to the DSF Hub. Fields that the provider models must be set with their attribute.",
+			Optional:         true,
+			ValidateDiagFunc: validateJsonObject,
+		},
+		"force_destroy": {
+			Type:        schema.TypeBool,
+			Description: "If true, the asset is deleted even if other assets depend on it, which otherwise fails with their asset_ids. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"gateway_id": {
+			Type:        schema.TypeString,
+			Description: "The jsonarUid unique identifier of the agentless gateway. Example: '7a4af7cf-4292-89d9-46ec-183756ksdjd'",
+			Required:    true,
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier for the asset",
+			Computed:    true,
+		},
+		"jsonar_uid": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier (UID) attached to the Agentless Gateway controlling the asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"jsonar_uid_display_name": {
+			Type:        schema.TypeString,
+			Description: "Unique identifier (UID) attached to the Agentless Gateway controlling the asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"location": {
+			Type:        schema.TypeString,
+			Description: "Current human-readable description of the physical location of the asset, or region.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"managed_by": {
+			Type:        schema.TypeString,
+			Description: "Email of the person who maintains the asset; can be different from the owner specified in the owned_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"override_ownership": {
+			Type:        schema.TypeBool,
+			Description: "If true, the asset is updated and deleted even if its ownership marker is not the workspace_id of the provider, and takes the ownership marker of the workspace on update. Default: false",
+			Optional:    true,
+			Default:     false,
+		},
+		"owned_by": {
+			Type:        schema.TypeString,
+			Description: "Email of Owner / person responsible for the asset; can be different from the person in the managed_by field. Defaults to admin_email.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"proxy": {
+			Type:        schema.TypeString,
+			Description: "Proxy to use for AWS calls if aws_proxy_config is populated the proxy field will get populated from the http value there",
+			Optional:    true,
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "For cloud systems with regions, the default region or region used with this asset",
+			Optional:    true,
+			Computed:    true,
+		},
+		"server_host_name": {
+			Type:        schema.TypeString,
+			Description: "Hostname (or IP if name is unknown)",
+			Optional:    true,
+		},
+		"server_ip": {
+			Type:        schema.TypeString,
+			Description: "IP address of the service where this asset is located. If no IP is available populate this field with other information that would identify the system e.g. hostname or AWS ARN, etc.",
+			Optional:    true,
+		},
+		"server_port": {
+			Type:        schema.TypeString,
+			Description: "",
+			Optional:    true,
+		},
+		"server_type": {
+			Type:        schema.TypeString,
+			Description: "The type of service to be created as a secret manager. Available values include AWS, CYBERARK, and HASHICORP.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service_endpoints": {
+			Type:        schema.TypeSet,
+			Description: "Specify particular endpoints for a given service in the form of <service name>: \"endpoint\"",
+			Optional:    true,
+			Set:         resourceAssetDataServiceEndpointsHash,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"logs": {
+						Type:        schema.TypeString,
+						Description: "The log endpoint for a given service",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"unmodelled_asset_data": {
+			Type:        schema.TypeString,
+			Description: "JSON object of the assetData fields returned by the DSF Hub that the provider does not model",
+			Computed:    true,
+		},
+		"used_for": {
+			Type:        schema.TypeString,
+			Description: "Designates how this asset is used / the environment that the asset is supporting.",
+			Optional:    true,
+		},
+	}
+}
@@ -0,0 +1,210 @@
+package dsfhub
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// assetSchemaVersion is the SchemaVersion of the asset resources. Version 1
+// holds the changes made in 1.4.0: version renamed to asset_version, searches
+// and other string attributes turned into lists, and deprecated fields removed.
+// Version 2 turns asset_connection from a set into a list keyed by reason.
+// Version 3 turns oauth_parameters from a set of strings into a map.
+const assetSchemaVersion = 3
+
+// deprecatedAssetAttributesV0 lists the attributes removed in schema version 1,
+// either at the top level or in asset_connection
+var deprecatedAssetAttributesV0 = []string{
+	"access_method",
+	"base_dn",
+	"credential_expiry",
+	"credential_fields",
+	"ntlm",
+	"page_size",
+	"smtp_timeout",
+}
+
+// assetStateUpgradersV0 returns the state upgraders of an asset resource
+func assetStateUpgradersV0(r *schema.Resource) []schema.StateUpgrader {
+	return []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    assetResourceTypeV0(r),
+			Upgrade: assetStateUpgradeV0(r),
+		},
+		{
+			Version: 1,
+			Type:    assetResourceTypeV1(r),
+			Upgrade: assetStateUpgradeV1,
+		},
+		{
+			Version: 2,
+			Type:    assetResourceTypeV2(r),
+			Upgrade: assetStateUpgradeV2,
+		},
+	}
+}
+
+// assetResourceTypeV2 returns the type of an asset resource in schema version
+// 2, which is only used by terraform to decode legacy flatmap states
+func assetResourceTypeV2(r *schema.Resource) cty.Type {
+	attributeTypes := r.CoreConfigSchema().ImpliedType().AttributeTypes()
+	attributes := make(map[string]cty.Type, len(attributeTypes))
+	for name, attributeType := range attributeTypes {
+		attributes[name] = attributeType
+	}
+	if connectionType, ok := attributes["asset_connection"]; ok && connectionType.IsListType() && connectionType.ElementType().IsObjectType() {
+		connectionAttributes := map[string]cty.Type{}
+		for name, attributeType := range connectionType.ElementType().AttributeTypes() {
+			connectionAttributes[name] = attributeType
+		}
+		if _, ok := connectionAttributes["oauth_parameters"]; ok {
+			connectionAttributes["oauth_parameters"] = cty.Set(cty.String)
+		}
+		attributes["asset_connection"] = cty.List(cty.Object(connectionAttributes))
+	}
+	return cty.Object(attributes)
+}
+
+// assetResourceTypeV1 returns the type of an asset resource in schema version
+// 1, which is only used by terraform to decode legacy flatmap states
+func assetResourceTypeV1(r *schema.Resource) cty.Type {
+	attributeTypes := assetResourceTypeV2(r).AttributeTypes()
+	attributes := make(map[string]cty.Type, len(attributeTypes))
+	for name, attributeType := range attributeTypes {
+		attributes[name] = attributeType
+	}
+	if connectionType, ok := attributes["asset_connection"]; ok && connectionType.IsListType() {
+		attributes["asset_connection"] = cty.Set(connectionType.ElementType())
+	}
+	return cty.Object(attributes)
+}
+
+// assetResourceTypeV0 returns the type of an asset resource in schema version
+// 0, which is only used by terraform to decode legacy flatmap states
+func assetResourceTypeV0(r *schema.Resource) cty.Type {
+	attributeTypes := assetResourceTypeV1(r).AttributeTypes()
+	attributes := make(map[string]cty.Type, len(attributeTypes)+1)
+	for name, attributeType := range attributeTypes {
+		attributes[name] = attributeType
+	}
+	delete(attributes, "asset_version")
+	attributes["version"] = cty.Number
+	for name, attributeSchema := range r.Schema {
+		if isStringListSchema(attributeSchema) {
+			attributes[name] = cty.String
+		}
+	}
+	return cty.Object(attributes)
+}
+
+// isStringListSchema returns true for list of strings attributes
+func isStringListSchema(attributeSchema *schema.Schema) bool {
+	if attributeSchema.Type != schema.TypeList {
+		return false
+	}
+	elem, ok := attributeSchema.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
+
+// assetStateUpgradeV0 upgrades the state of an asset resource from schema
+// version 0 to 1
+func assetStateUpgradeV0(r *schema.Resource) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+
+		// version was renamed to asset_version
+		if version, ok := rawState["version"]; ok {
+			if rawState["asset_version"] == nil {
+				rawState["asset_version"] = upgradeAssetVersionV0(version)
+			}
+			delete(rawState, "version")
+		}
+
+		// searches and other attributes were turned from strings into lists
+		for name, attributeSchema := range r.Schema {
+			value, ok := rawState[name].(string)
+			if !ok || !isStringListSchema(attributeSchema) {
+				continue
+			}
+			log.Printf("[DEBUG] Upgrading %s from a string to a list\n", name)
+			if value == "" {
+				rawState[name] = nil
+			} else {
+				rawState[name] = []interface{}{value}
+			}
+		}
+
+		// deprecated fields were removed
+		for _, name := range deprecatedAssetAttributesV0 {
+			delete(rawState, name)
+		}
+		if connections, ok := rawState["asset_connection"].([]interface{}); ok {
+			for _, connection := range connections {
+				if connectionMap, ok := connection.(map[string]interface{}); ok {
+					for _, name := range deprecatedAssetAttributesV0 {
+						delete(connectionMap, name)
+					}
+				}
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+// assetStateUpgradeV1 upgrades the state of an asset resource from schema
+// version 1 to 2. The asset_connection blocks keep the order in which the set
+// was stored, so that upgrading does not reorder the connections in the plan.
+func assetStateUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return rawState, nil
+}
+
+// assetStateUpgradeV2 upgrades the state of an asset resource from schema
+// version 2 to 3. oauth_parameters could not be set as a set of strings, which
+// had no parameter names, so it is dropped.
+func assetStateUpgradeV2(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	if connections, ok := rawState["asset_connection"].([]interface{}); ok {
+		for _, connection := range connections {
+			connectionMap, ok := connection.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if parameters, ok := connectionMap["oauth_parameters"].([]interface{}); ok {
+				if len(parameters) > 0 {
+					log.Printf("[WARN] Dropping oauth_parameters %v which have no parameter names\n", parameters)
+				}
+				connectionMap["oauth_parameters"] = nil
+			}
+		}
+	}
+	return rawState, nil
+}
+
+// upgradeAssetVersionV0 returns the asset_version for the value of version,
+// which was stored as a string by some versions of the provider
+func upgradeAssetVersionV0(version interface{}) interface{} {
+	s, ok := version.(string)
+	if !ok {
+		return version
+	}
+	if s == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		log.Printf("[WARN] Dropping version %q which is not a number\n", s)
+		return nil
+	}
+	return f
+}
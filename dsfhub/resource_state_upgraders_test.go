@@ -0,0 +1,187 @@
+package dsfhub
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testDataSourceStateV0 is the state of a dsfhub_data_source written by the
+// provider before 1.4.0
+const testDataSourceStateV0 = `{
+	"id": "arn:aws:rds:us-east-2:123456789012:db:my-db",
+	"access_method": "api",
+	"admin_email": "admin@example.com",
+	"asset_display_name": "my-db",
+	"asset_id": "arn:aws:rds:us-east-2:123456789012:db:my-db",
+	"audit_pull_enabled": true,
+	"gateway_id": "my-gateway",
+	"page_size": "100",
+	"searches": "my-search",
+	"server_type": "AWS RDS MYSQL",
+	"version": 8.0,
+	"asset_connection": [
+		{
+			"auth_mechanism": "password",
+			"base_dn": "dc=example,dc=com",
+			"credential_fields": "password",
+			"password": "my-password",
+			"reason": "default",
+			"username": "admin"
+		}
+	]
+}`
+
+// testLogAggregatorStateV0 is the state of a dsfhub_log_aggregator written by
+// the provider before 1.4.0
+const testLogAggregatorStateV0 = `{
+	"id": "arn:aws:logs:us-east-2:123456789012:log-group:my-log-group",
+	"admin_email": "admin@example.com",
+	"asset_display_name": "my-log-group",
+	"asset_id": "arn:aws:logs:us-east-2:123456789012:log-group:my-log-group",
+	"available_regions": "",
+	"credential_expiry": "2024-01-01",
+	"gateway_id": "my-gateway",
+	"server_type": "AWS LOG GROUP",
+	"version": "1.5"
+}`
+
+// testUpgradeAssetState upgrades a version 0 state fixture through every
+// state upgrader, checking that the result can be decoded with the current
+// schema of the resource
+func testUpgradeAssetState(t *testing.T, r *schema.Resource, stateV0 string) map[string]interface{} {
+	var upgraded map[string]interface{}
+	if err := json.Unmarshal([]byte(stateV0), &upgraded); err != nil {
+		t.Fatalf("Should have parsed the state fixture: %s", err)
+	}
+
+	if r.SchemaVersion != assetSchemaVersion || len(r.StateUpgraders) != assetSchemaVersion {
+		t.Fatalf("Should have a state upgrader from each version to %d", assetSchemaVersion)
+	}
+	for version, upgrader := range r.StateUpgraders {
+		if upgrader.Version != version {
+			t.Fatalf("Should have a state upgrader from version %d. Got: %d", version, upgrader.Version)
+		}
+		var err error
+		upgraded, err = upgrader.Upgrade(context.Background(), upgraded, nil)
+		if err != nil {
+			t.Fatalf("Should not have received an error upgrading from version %d: %s", version, err)
+		}
+	}
+
+	upgradedJson, _ := json.Marshal(upgraded)
+	if _, err := ctyjson.Unmarshal(upgradedJson, r.CoreConfigSchema().ImpliedType()); err != nil {
+		t.Errorf("Should have decoded the upgraded state with the current schema: %s", err)
+	}
+	return upgraded
+}
+
+func TestDataSourceStateUpgradeV0(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestDataSourceStateUpgradeV0 \n")
+
+	upgraded := testUpgradeAssetState(t, resourceDSFDataSource(), testDataSourceStateV0)
+
+	if upgraded["asset_version"] != 8.0 {
+		t.Errorf("Should have moved version to asset_version. Got: %v", upgraded["asset_version"])
+	}
+	if !reflect.DeepEqual(upgraded["searches"], []interface{}{"my-search"}) {
+		t.Errorf("Should have turned searches into a list. Got: %v", upgraded["searches"])
+	}
+	for _, name := range []string{"version", "access_method", "page_size"} {
+		if _, found := upgraded[name]; found {
+			t.Errorf("Should have removed %s", name)
+		}
+	}
+	connection := upgraded["asset_connection"].([]interface{})[0].(map[string]interface{})
+	for _, name := range []string{"base_dn", "credential_fields"} {
+		if _, found := connection[name]; found {
+			t.Errorf("Should have removed asset_connection.%s", name)
+		}
+	}
+	if connection["password"] != "my-password" {
+		t.Errorf("Should have kept asset_connection.password. Got: %v", connection["password"])
+	}
+}
+
+func TestLogAggregatorStateUpgradeV0(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestLogAggregatorStateUpgradeV0 \n")
+
+	upgraded := testUpgradeAssetState(t, resourceLogAggregator(), testLogAggregatorStateV0)
+
+	if upgraded["asset_version"] != 1.5 {
+		t.Errorf("Should have parsed version into asset_version. Got: %v", upgraded["asset_version"])
+	}
+	if upgraded["available_regions"] != nil {
+		t.Errorf("Should have turned an empty available_regions into null. Got: %v", upgraded["available_regions"])
+	}
+	if _, found := upgraded["credential_expiry"]; found {
+		t.Errorf("Should have removed credential_expiry")
+	}
+}
+
+func TestAssetStateUpgradeV0KeepsAssetVersion(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetStateUpgradeV0KeepsAssetVersion \n")
+
+	for _, r := range []*schema.Resource{resourceCloudAccount(), resourceSecretManager()} {
+		upgraded := testUpgradeAssetState(t, r, `{"asset_id":"my-asset","asset_version":2.0,"version":1.0}`)
+		if upgraded["asset_version"] != 2.0 {
+			t.Errorf("Should have kept asset_version. Got: %v", upgraded["asset_version"])
+		}
+	}
+}
+
+func TestAssetStateUpgradeV1KeepsConnectionOrder(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetStateUpgradeV1KeepsConnectionOrder \n")
+
+	rawState := map[string]interface{}{
+		"asset_id": "my-asset",
+		"asset_connection": []interface{}{
+			map[string]interface{}{"reason": "sonargateway", "username": "gateway"},
+			map[string]interface{}{"reason": "default", "username": "admin"},
+		},
+	}
+	upgraded, err := assetStateUpgradeV1(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+
+	var reasons []string
+	for _, connection := range upgraded["asset_connection"].([]interface{}) {
+		reasons = append(reasons, connectionReason(connection))
+	}
+	if !reflect.DeepEqual(reasons, []string{"sonargateway", "default"}) {
+		t.Errorf("Should have kept the order of asset_connection. Got: %v", reasons)
+	}
+}
+
+func TestAssetStateUpgradeV2DropsOauthParameters(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[INFO] Running test TestAssetStateUpgradeV2DropsOauthParameters \n")
+
+	rawState := map[string]interface{}{
+		"asset_connection": []interface{}{
+			map[string]interface{}{"reason": "default", "oauth_parameters": []interface{}{}},
+		},
+	}
+	upgraded, err := assetStateUpgradeV2(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("Should not have received an error: %s", err)
+	}
+
+	connection := upgraded["asset_connection"].([]interface{})[0].(map[string]interface{})
+	if connection["oauth_parameters"] != nil {
+		t.Errorf("Should have dropped oauth_parameters. Got: %v", connection["oauth_parameters"])
+	}
+	if connection["reason"] != "default" {
+		t.Errorf("Should have kept reason. Got: %v", connection["reason"])
+	}
+}
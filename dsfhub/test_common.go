@@ -7,7 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // testAccParseResourceAttributeReference parses a terraform field and
@@ -60,16 +62,6 @@ func createIgnoreChangesBlock(ignoredAttributes []string) string {
 	return ignoreChangesBlock
 }
 
-// ignoreAssetConnectionBlock builds the lifecycle meta argument block to ignore
-// fields masked in the connection.
-// e.g. client_secret   = "*****", password = "*****"
-func ignoreAssetConnectionChangesBlock() string {
-	var lifecycleBlock string
-	ignoredAttributes := []string{`asset_connection`}
-	lifecycleBlock = createIgnoreChangesBlock(ignoredAttributes)
-	return lifecycleBlock
-}
-
 // checkGatewayId checks that the GATEWAY_ID environment variable is set correctly
 // for acceptance tests
 func checkGatewayId(t *testing.T) string {
@@ -204,3 +196,27 @@ func skipTestForKnownIssue(t *testing.T, version string, details string) {
 		t.Skipf("Skipping test %s for DSFHUB_VERSION '%s', details: '%s'", t.Name(), dsfhubVersion, details)
 	}
 }
+
+// testRawConfig returns the raw configuration terraform would send for a
+// resource with the given string attributes set, and all other attributes
+// null. Unit tests set it on the prior state so that CustomizeDiff functions
+// can tell configured attributes from computed ones.
+func testRawConfig(r *schema.Resource, values map[string]string) cty.Value {
+	attrs := map[string]cty.Value{}
+	for name, attrType := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		if value, ok := values[name]; ok {
+			attrs[name] = cty.StringVal(value)
+		} else {
+			attrs[name] = cty.NullVal(attrType)
+		}
+	}
+	return cty.ObjectVal(attrs)
+}
+
+// testConfiguredResource returns a resource of the provider configured with
+// client, whose configuration validators depend on the provider settings
+func testConfiguredResource(resourceType string, client *Client) *schema.Resource {
+	provider := Provider()
+	provider.SetMeta(client)
+	return provider.ResourcesMap[resourceType]
+}